@@ -0,0 +1,250 @@
+package boleto
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+var (
+	// ErrInvalidValueIdentifier is returned by Validate and ValidateBarcode
+	// when the value identifier of an arrecadação slip is not 6, 7, 8 or 9.
+	ErrInvalidValueIdentifier = errors.New("boleto: invalid value identifier")
+	// ErrInvalidKind is returned by the Parse functions when the input is
+	// valid but belongs to the other kind of slip: an arrecadação slip given
+	// to Parse, or a bank boleto given to ParseArrecadacao.
+	ErrInvalidKind = errors.New("boleto: invalid kind")
+)
+
+// Every Digitable Line from an arrecadação slip (utilities, taxes and
+// convênios) has exactly 48 digits and starts with the product ID 8
+const arrecadacaoLineLength = 48
+
+const arrecadacaoProductID = '8'
+
+// The Digitable Line of an arrecadação slip has 4 blocks with 11 digits of
+// the Barcode followed by the block check digit
+const (
+	arrecadacaoBlockSize  = 11
+	arrecadacaoBlockCount = 4
+)
+
+// Position of the general check digit in an arrecadação Barcode
+const arrecadacaoCheckDigitPosition = 3
+
+// Segment of the arrecadação slip that identifies the company by its CNPJ
+const cnpjSegment = 6
+
+// Slices of the arrecadação Barcode holding each field
+var (
+	segmentPosition         = [2]int{1, 2}
+	valueIdentifierPosition = [2]int{2, 3}
+	arrecadacaoAmountPos    = [2]int{4, 15}
+	companyIDPosition       = [2]int{15, 19}
+	cnpjRootPosition        = [2]int{15, 23}
+)
+
+// segmentNames describes each arrecadação segment
+var segmentNames = map[int]string{
+	1: "Prefeituras",
+	2: "Saneamento",
+	3: "Energia Elétrica e Gás",
+	4: "Telecomunicações",
+	5: "Órgãos Governamentais",
+	6: "Carnês e Assemelhados ou demais Empresas / Órgãos identificados por CNPJ",
+	7: "Multas de Trânsito",
+	9: "Uso Exclusivo do Banco",
+}
+
+// Arrecadacao holds the data encoded in an arrecadação slip: utility bills,
+// taxes (DAS, IPTU) and other convênios whose Digitable Line starts with 8.
+type Arrecadacao struct {
+	// Segment identifies the kind of collector (e.g. 3 for energy and gas).
+	Segment int
+	// ValueIdentifier selects the check digit algorithm (6 and 7 use mod 10,
+	// 8 and 9 use mod 11) and how Amount is read.
+	ValueIdentifier int
+	// ReferenceAmount is true when Amount is a reference value (value
+	// identifier 7 or 9) rather than the effective amount in centavos.
+	ReferenceAmount bool
+	// Amount is the 11-digit amount field; in centavos when it is effective.
+	Amount int64
+	// CompanyID identifies the company or agency: 4 digits, or the first
+	// 8 digits of its CNPJ for segment 6.
+	CompanyID string
+	// FreeField is the company-specific remainder of the Barcode.
+	FreeField string
+	// Barcode is the 44-digit Barcode.
+	Barcode string
+	// DigitableLine is the 48-digit Digitable Line.
+	DigitableLine string
+}
+
+// SegmentName returns the description of the arrecadação segment.
+func (a *Arrecadacao) SegmentName() string {
+	return segmentNames[a.Segment]
+}
+
+// ParseArrecadacao decodes the 48-digit Digitable Line of an arrecadação slip.
+// Returns the error reported by Validate if the Digitable Line is invalid,
+// or ErrInvalidKind if it is a bank boleto.
+func ParseArrecadacao(digitableLine string) (*Arrecadacao, error) {
+	barcode, err := ToBarcode(digitableLine)
+	if err != nil {
+		return nil, err
+	}
+	if !IsArrecadacao(barcode) {
+		return nil, ErrInvalidKind
+	}
+
+	return parseArrecadacaoBarcode(barcode, helpers.OnlyNumbers(digitableLine)), nil
+}
+
+// ParseArrecadacaoBarcode decodes the 44-digit Barcode of an arrecadação slip.
+// Returns the error reported by ValidateBarcode if the Barcode is invalid,
+// or ErrInvalidKind if it is a bank boleto.
+func ParseArrecadacaoBarcode(barcode string) (*Arrecadacao, error) {
+	digitableLine, err := FromBarcode(barcode)
+	if err != nil {
+		return nil, err
+	}
+	if !IsArrecadacao(barcode) {
+		return nil, ErrInvalidKind
+	}
+
+	return parseArrecadacaoBarcode(helpers.OnlyNumbers(barcode), digitableLine), nil
+}
+
+// IsArrecadacao reports whether the Digitable Line or Barcode belongs to an
+// arrecadação slip, which starts with 8. It does not validate the input.
+func IsArrecadacao(digitableLineOrBarcode string) bool {
+	numbers := helpers.OnlyNumbers(digitableLineOrBarcode)
+	return numbers != "" && numbers[0] == arrecadacaoProductID
+}
+
+func parseArrecadacaoBarcode(barcode string, digitableLine string) *Arrecadacao {
+	segment, _ := strconv.Atoi(field(barcode, segmentPosition))
+	valueIdentifier, _ := strconv.Atoi(field(barcode, valueIdentifierPosition))
+	amount, _ := strconv.ParseInt(field(barcode, arrecadacaoAmountPos), 10, 64)
+
+	companyIDPosition := companyIDPosition
+	if segment == cnpjSegment {
+		companyIDPosition = cnpjRootPosition
+	}
+
+	return &Arrecadacao{
+		Segment:         segment,
+		ValueIdentifier: valueIdentifier,
+		ReferenceAmount: valueIdentifier == 7 || valueIdentifier == 9,
+		Amount:          amount,
+		CompanyID:       field(barcode, companyIDPosition),
+		FreeField:       barcode[companyIDPosition[1]:],
+		Barcode:         barcode,
+		DigitableLine:   digitableLine,
+	}
+}
+
+// validateArrecadacaoLine validates the 48-digit Digitable Line of an
+// arrecadação slip
+func validateArrecadacaoLine(digitableLine string) error {
+	barcode := parseArrecadacaoLine(digitableLine)
+
+	checkDigit, ok := arrecadacaoCheckDigitFunc(barcode)
+	if !ok {
+		return ErrInvalidValueIdentifier
+	}
+
+	for block := 0; block < arrecadacaoBlockCount; block++ {
+		start := block * (arrecadacaoBlockSize + 1)
+		digits := digitableLine[start : start+arrecadacaoBlockSize]
+		if strconv.Itoa(checkDigit(digits)) != string(digitableLine[start+arrecadacaoBlockSize]) {
+			return ErrInvalidFieldCheckDigit
+		}
+	}
+
+	return validateArrecadacaoBarcode(barcode)
+}
+
+// validateArrecadacaoBarcode verifies the value identifier and the general
+// check digit of an arrecadação Barcode
+func validateArrecadacaoBarcode(barcode string) error {
+	checkDigit, ok := arrecadacaoCheckDigitFunc(barcode)
+	if !ok {
+		return ErrInvalidValueIdentifier
+	}
+
+	expected := checkDigit(barcode[:arrecadacaoCheckDigitPosition] + barcode[arrecadacaoCheckDigitPosition+1:])
+	if strconv.Itoa(expected) != string(barcode[arrecadacaoCheckDigitPosition]) {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// parseArrecadacaoLine removes the block check digits of an arrecadação
+// Digitable Line, resulting in its Barcode
+func parseArrecadacaoLine(digitableLine string) string {
+	buf := bytes.Buffer{}
+	for block := 0; block < arrecadacaoBlockCount; block++ {
+		start := block * (arrecadacaoBlockSize + 1)
+		buf.WriteString(digitableLine[start : start+arrecadacaoBlockSize])
+	}
+	return buf.String()
+}
+
+// arrecadacaoLineFromBarcode appends the block check digits to each block
+// of an arrecadação Barcode
+func arrecadacaoLineFromBarcode(barcode string) string {
+	checkDigit, _ := arrecadacaoCheckDigitFunc(barcode)
+
+	buf := bytes.Buffer{}
+	for block := 0; block < arrecadacaoBlockCount; block++ {
+		digits := barcode[block*arrecadacaoBlockSize : (block+1)*arrecadacaoBlockSize]
+		buf.WriteString(digits)
+		buf.WriteString(strconv.Itoa(checkDigit(digits)))
+	}
+	return buf.String()
+}
+
+// arrecadacaoCheckDigitFunc returns the check digit algorithm selected by
+// the value identifier of an arrecadação Barcode
+func arrecadacaoCheckDigitFunc(barcode string) (func(string) int, bool) {
+	switch barcode[valueIdentifierPosition[0]] {
+	case '6', '7':
+		return getMod10, true
+	case '8', '9':
+		return getArrecadacaoMod11, true
+	default:
+		return nil, false
+	}
+}
+
+// getArrecadacaoMod11 computes the mod 11 check digit of arrecadação slips,
+// which is 0 when the remainder is 0 or 1
+func getArrecadacaoMod11(value string) int {
+	weight := mod11Weights.initial
+
+	var sum int
+	valueReversed := helpers.Reverse(value)
+
+	for _, valueRune := range valueReversed {
+		valueValue, _ := strconv.Atoi(fmt.Sprintf("%c", valueRune))
+		sum += valueValue * weight
+
+		if weight < mod11Weights.end {
+			weight++
+		} else {
+			weight = mod11Weights.initial
+		}
+	}
+
+	mod11 := sum % 11
+	if mod11 == 0 || mod11 == 1 {
+		return 0
+	}
+
+	return 11 - mod11
+}
@@ -0,0 +1,131 @@
+package boleto_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/boleto"
+)
+
+const (
+	validArrecadacaoLine    = "846700000017435900240209024050002435842210108119"
+	validArrecadacaoBarcode = "84670000001435900240200240500024384221010811"
+	mod11ArrecadacaoLine    = "868100000010234560391940720261017003000000000019"
+	mod11ArrecadacaoBarcode = "86810000001234560391947202610170000000000001"
+)
+
+var validateArrecadacaoTests = []struct {
+	input    string
+	expected error
+}{
+	{validArrecadacaoLine, nil},
+	{"84670000001-7 43590024020-9 02405000243-5 84221010811-9", nil},
+	{mod11ArrecadacaoLine, nil},
+	{"846700000018435900240209024050002435842210108119", boleto.ErrInvalidFieldCheckDigit},
+	{"846000000014435900240209024050002435842210108119", boleto.ErrInvalidCheckDigit},
+	{"845700000017435900240209024050002435842210108119", boleto.ErrInvalidValueIdentifier},
+	{"946700000017435900240209024050002435842210108119", boleto.ErrInvalidLength},
+	{"8467000000174359002402090240500024358422101081", boleto.ErrInvalidLength},
+}
+
+func TestValidateArrecadacao(t *testing.T) {
+	for _, table := range validateArrecadacaoTests {
+		if err := boleto.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+var arrecadacaoBarcodeTests = []struct {
+	digitableLine string
+	barcode       string
+}{
+	{validArrecadacaoLine, validArrecadacaoBarcode},
+	{mod11ArrecadacaoLine, mod11ArrecadacaoBarcode},
+}
+
+func TestArrecadacaoBarcode(t *testing.T) {
+	for _, table := range arrecadacaoBarcodeTests {
+		if res, err := boleto.ToBarcode(table.digitableLine); res != table.barcode || err != nil {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.digitableLine, table.barcode, res, err)
+		}
+		if res, err := boleto.FromBarcode(table.barcode); res != table.digitableLine || err != nil {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.barcode, table.digitableLine, res, err)
+		}
+	}
+
+	if err := boleto.ValidateBarcode("84600000001435900240200240500024384221010811"); !errors.Is(err, boleto.ErrInvalidCheckDigit) {
+		t.Errorf("Expected ErrInvalidCheckDigit, got %v", err)
+	}
+}
+
+var parseArrecadacaoTests = []struct {
+	input    string
+	expected boleto.Arrecadacao
+}{
+	{
+		validArrecadacaoLine,
+		boleto.Arrecadacao{
+			Segment:         4,
+			ValueIdentifier: 6,
+			ReferenceAmount: false,
+			Amount:          14359,
+			CompanyID:       "0024",
+			FreeField:       "0200240500024384221010811",
+			Barcode:         validArrecadacaoBarcode,
+			DigitableLine:   validArrecadacaoLine,
+		},
+	},
+	{
+		mod11ArrecadacaoLine,
+		boleto.Arrecadacao{
+			Segment:         6,
+			ValueIdentifier: 8,
+			ReferenceAmount: false,
+			Amount:          12345,
+			CompanyID:       "60391947",
+			FreeField:       "202610170000000000001",
+			Barcode:         mod11ArrecadacaoBarcode,
+			DigitableLine:   mod11ArrecadacaoLine,
+		},
+	},
+}
+
+func TestParseArrecadacao(t *testing.T) {
+	for _, table := range parseArrecadacaoTests {
+		parsed, err := boleto.ParseArrecadacao(table.input)
+		if err != nil {
+			t.Fatalf("Failing for %v \t Unexpected error: %v", table.input, err)
+		}
+		if *parsed != table.expected {
+			t.Errorf("Failing for %v \t Expected: %+v | Received: %+v", table.input, table.expected, *parsed)
+		}
+
+		parsed, err = boleto.ParseArrecadacaoBarcode(table.expected.Barcode)
+		if err != nil || *parsed != table.expected {
+			t.Errorf("Failing for %v \t Expected: %+v | Received: %+v (%v)", table.expected.Barcode, table.expected, parsed, err)
+		}
+	}
+}
+
+func TestParseArrecadacaoSegmentName(t *testing.T) {
+	parsed, _ := boleto.ParseArrecadacao(validArrecadacaoLine)
+	if res := parsed.SegmentName(); res != "Telecomunicações" {
+		t.Errorf("Expected Telecomunicações, got %v", res)
+	}
+}
+
+func TestParseInvalidKind(t *testing.T) {
+	if _, err := boleto.ParseArrecadacao(validDigitableLine); !errors.Is(err, boleto.ErrInvalidKind) {
+		t.Errorf("Expected ErrInvalidKind, got %v", err)
+	}
+	if _, err := boleto.ParseArrecadacaoBarcode(validBarcode); !errors.Is(err, boleto.ErrInvalidKind) {
+		t.Errorf("Expected ErrInvalidKind, got %v", err)
+	}
+	if _, err := boleto.Parse(validArrecadacaoLine); !errors.Is(err, boleto.ErrInvalidKind) {
+		t.Errorf("Expected ErrInvalidKind, got %v", err)
+	}
+	if _, err := boleto.ParseBarcode(validArrecadacaoBarcode); !errors.Is(err, boleto.ErrInvalidKind) {
+		t.Errorf("Expected ErrInvalidKind, got %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package boleto
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BancoDoBrasilDecoder decodes the free field of Banco do Brasil (001),
+// whose layout depends on the length of the convênio (agreement number):
+//
+//   - 7 digits: six zeros, the 17-digit nosso número (convênio and sequence)
+//     and the carteira.
+//   - 6 digits with a 17-digit nosso número: the convênio, the nosso número
+//     and the service ID 21.
+//   - 4 or 6 digits otherwise: the 11-digit nosso número, starting with the
+//     convênio, followed by the agency, the account and the carteira.
+//
+// AgreementLength sets the length of the beneficiary's convênio. When it is
+// 0, as in the registered decoder, the layout is inferred: convênios do not
+// start with zero, so six leading zeros mark a 7-digit convênio, and 21 is a
+// service ID rather than a carteira.
+type BancoDoBrasilDecoder struct {
+	AgreementLength int
+}
+
+// Decode implements FreeFieldDecoder. Returns ErrUnknownFreeFieldLayout if
+// the layout cannot be inferred or does not match AgreementLength.
+func (d BancoDoBrasilDecoder) Decode(freeField string) (*FreeField, error) {
+	if err := checkFreeField(freeField); err != nil {
+		return nil, err
+	}
+
+	agreementLength := d.AgreementLength
+	if agreementLength == 0 {
+		agreementLength = bancoDoBrasilAgreementLength(freeField)
+	}
+
+	switch {
+	case agreementLength == 7 && strings.HasPrefix(freeField, "000000") && freeField[6] != '0':
+		return &FreeField{
+			Beneficiary: freeField[6:13],
+			NossoNumero: freeField[6:23],
+			Carteira:    freeField[23:25],
+		}, nil
+	case agreementLength == 6 && strings.HasSuffix(freeField, bancoDoBrasilServiceID) && freeField[0] != '0':
+		return &FreeField{
+			Beneficiary: freeField[0:6],
+			NossoNumero: freeField[6:23],
+		}, nil
+	case (agreementLength == 4 || agreementLength == 6) && !strings.HasSuffix(freeField, bancoDoBrasilServiceID):
+		return &FreeField{
+			NossoNumero: freeField[0:11],
+			Agency:      freeField[11:15],
+			Account:     freeField[15:23],
+			Carteira:    freeField[23:25],
+		}, nil
+	default:
+		return nil, ErrUnknownFreeFieldLayout
+	}
+}
+
+// Service ID that ends the free field of a 6-digit convênio with a 17-digit
+// nosso número
+const bancoDoBrasilServiceID = "21"
+
+// bancoDoBrasilAgreementLength infers the length of the convênio from the
+// structure of the free field, or returns 0 if it cannot be told. The 4 and
+// 6-digit convênios with an 11-digit nosso número share a layout, reported
+// as 4.
+func bancoDoBrasilAgreementLength(freeField string) int {
+	switch {
+	case strings.HasPrefix(freeField, "000000"):
+		return 7
+	case freeField[0] == '0':
+		// Too few zeros for a 7-digit convênio and a leading zero for a
+		// shorter one
+		return 0
+	case strings.HasSuffix(freeField, bancoDoBrasilServiceID):
+		return 6
+	default:
+		return 4
+	}
+}
+
+// NossoNumeroCheckDigit implements FreeFieldDecoder. The 17-digit nosso
+// número has no check digit, so it returns "" for it.
+func (BancoDoBrasilDecoder) NossoNumeroCheckDigit(freeField *FreeField) string {
+	if len(freeField.NossoNumero) != 11 {
+		return ""
+	}
+
+	remainder := weightedSum(freeField.NossoNumero, []int{9, 8, 7, 6, 5, 4, 3, 2}) % 11
+	if remainder == 10 {
+		return "X"
+	}
+	return strconv.Itoa(remainder)
+}
+
+// SantanderDecoder decodes the free field of Santander (033): the digit 9,
+// the 7-digit beneficiary code, the 12-digit nosso número and its check
+// digit, the IOF digit and the 3-digit carteira.
+type SantanderDecoder struct{}
+
+// Decode implements FreeFieldDecoder.
+func (d SantanderDecoder) Decode(freeField string) (*FreeField, error) {
+	if err := checkFreeField(freeField); err != nil {
+		return nil, err
+	}
+	if freeField[0] != '9' {
+		return nil, ErrInvalidFreeField
+	}
+
+	decoded := &FreeField{
+		Beneficiary:           freeField[1:8],
+		NossoNumero:           freeField[8:20],
+		NossoNumeroCheckDigit: freeField[20:21],
+		Carteira:              freeField[22:25],
+	}
+	if d.NossoNumeroCheckDigit(decoded) != decoded.NossoNumeroCheckDigit {
+		return nil, ErrInvalidNossoNumeroCheckDigit
+	}
+
+	return decoded, nil
+}
+
+// NossoNumeroCheckDigit implements FreeFieldDecoder.
+func (SantanderDecoder) NossoNumeroCheckDigit(freeField *FreeField) string {
+	return strconv.Itoa(mod11Digit(freeField.NossoNumero))
+}
+
+// CaixaDecoder decodes the free field of Caixa Econômica Federal (104) in
+// the SIGCB layout: the 6-digit beneficiary code and its check digit, the
+// 17-digit nosso número interleaved with its constants, and the check digit
+// of the free field. The carteira is the first constant: 1 for registered
+// and 2 for unregistered boletos.
+type CaixaDecoder struct{}
+
+// Decode implements FreeFieldDecoder.
+func (CaixaDecoder) Decode(freeField string) (*FreeField, error) {
+	if err := checkFreeField(freeField); err != nil {
+		return nil, err
+	}
+	if strconv.Itoa(mod11Digit(freeField[0:6])) != freeField[6:7] {
+		return nil, ErrInvalidBeneficiaryCheckDigit
+	}
+	if strconv.Itoa(mod11Digit(freeField[0:24])) != freeField[24:25] {
+		return nil, ErrInvalidFreeFieldCheckDigit
+	}
+
+	return &FreeField{
+		Beneficiary: freeField[0:6],
+		Carteira:    freeField[10:11],
+		NossoNumero: freeField[10:11] + freeField[14:15] + freeField[7:10] + freeField[11:14] + freeField[15:24],
+	}, nil
+}
+
+// NossoNumeroCheckDigit implements FreeFieldDecoder.
+func (CaixaDecoder) NossoNumeroCheckDigit(freeField *FreeField) string {
+	return strconv.Itoa(mod11Digit(freeField.NossoNumero))
+}
+
+// BradescoDecoder decodes the free field of Bradesco (237): the 4-digit
+// agency, the 2-digit carteira, the 11-digit nosso número, the 7-digit
+// account and a zero.
+type BradescoDecoder struct{}
+
+// Decode implements FreeFieldDecoder.
+func (BradescoDecoder) Decode(freeField string) (*FreeField, error) {
+	if err := checkFreeField(freeField); err != nil {
+		return nil, err
+	}
+
+	return &FreeField{
+		Agency:      freeField[0:4],
+		Carteira:    freeField[4:6],
+		NossoNumero: freeField[6:17],
+		Account:     freeField[17:24],
+	}, nil
+}
+
+// NossoNumeroCheckDigit implements FreeFieldDecoder. The check digit covers
+// the carteira and the nosso número, and is "P" when the remainder is 1.
+func (BradescoDecoder) NossoNumeroCheckDigit(freeField *FreeField) string {
+	remainder := weightedSum(freeField.Carteira+freeField.NossoNumero, []int{2, 3, 4, 5, 6, 7}) % 11
+	switch remainder {
+	case 0:
+		return "0"
+	case 1:
+		return "P"
+	default:
+		return strconv.Itoa(11 - remainder)
+	}
+}
+
+// ItauDecoder decodes the free field of Itaú (341): the 3-digit carteira,
+// the 8-digit nosso número and its check digit (DAC), the 4-digit agency,
+// the 5-digit account, the check digit of agency and account, and 000.
+type ItauDecoder struct{}
+
+// Carteiras whose nosso número check digit leaves out agency and account
+var itauShortCarteiras = map[string]bool{"126": true, "131": true, "146": true, "150": true, "168": true}
+
+// Decode implements FreeFieldDecoder.
+func (d ItauDecoder) Decode(freeField string) (*FreeField, error) {
+	if err := checkFreeField(freeField); err != nil {
+		return nil, err
+	}
+
+	decoded := &FreeField{
+		Carteira:              freeField[0:3],
+		NossoNumero:           freeField[3:11],
+		NossoNumeroCheckDigit: freeField[11:12],
+		Agency:                freeField[12:16],
+		Account:               freeField[16:21],
+	}
+	if d.NossoNumeroCheckDigit(decoded) != decoded.NossoNumeroCheckDigit {
+		return nil, ErrInvalidNossoNumeroCheckDigit
+	}
+	if strconv.Itoa(getMod10(decoded.Agency+decoded.Account)) != freeField[21:22] {
+		return nil, ErrInvalidAgencyAccountCheckDigit
+	}
+
+	return decoded, nil
+}
+
+// NossoNumeroCheckDigit implements FreeFieldDecoder. The check digit covers
+// the agency, account, carteira and nosso número, or only the last two for
+// carteiras 126, 131, 146, 150 and 168.
+func (ItauDecoder) NossoNumeroCheckDigit(freeField *FreeField) string {
+	if itauShortCarteiras[freeField.Carteira] {
+		return strconv.Itoa(getMod10(freeField.Carteira + freeField.NossoNumero))
+	}
+	return strconv.Itoa(getMod10(freeField.Agency + freeField.Account + freeField.Carteira + freeField.NossoNumero))
+}
+
+// SicoobDecoder decodes the free field of Sicoob (756): the 1-digit
+// carteira, the 4-digit cooperative (as Agency), the 2-digit modality, the
+// 7-digit client code (as Beneficiary), the 7-digit nosso número and its
+// check digit, and the 3-digit installment.
+type SicoobDecoder struct{}
+
+// Decode implements FreeFieldDecoder.
+func (d SicoobDecoder) Decode(freeField string) (*FreeField, error) {
+	if err := checkFreeField(freeField); err != nil {
+		return nil, err
+	}
+
+	decoded := &FreeField{
+		Carteira:              freeField[0:1],
+		Agency:                freeField[1:5],
+		Beneficiary:           freeField[7:14],
+		NossoNumero:           freeField[14:21],
+		NossoNumeroCheckDigit: freeField[21:22],
+	}
+	if d.NossoNumeroCheckDigit(decoded) != decoded.NossoNumeroCheckDigit {
+		return nil, ErrInvalidNossoNumeroCheckDigit
+	}
+
+	return decoded, nil
+}
+
+// NossoNumeroCheckDigit implements FreeFieldDecoder. The check digit covers
+// the cooperative, the client code padded to 10 digits and the nosso número,
+// weighted by 3, 1, 9 and 7 from the left.
+func (SicoobDecoder) NossoNumeroCheckDigit(freeField *FreeField) string {
+	value := freeField.Agency + strings.Repeat("0", max(0, 10-len(freeField.Beneficiary))) + freeField.Beneficiary + freeField.NossoNumero
+	weights := []int{3, 1, 9, 7}
+
+	var sum int
+	for index, digit := range value {
+		sum += int(digit-'0') * weights[index%len(weights)]
+	}
+
+	remainder := sum % 11
+	if remainder == 0 || remainder == 1 {
+		return "0"
+	}
+	return strconv.Itoa(11 - remainder)
+}
+
+// weightedSum multiplies the digits of value by weights, cycling through
+// them from the rightmost digit
+func weightedSum(value string, weights []int) int {
+	var sum int
+	for index := 0; index < len(value); index++ {
+		digit := int(value[len(value)-1-index] - '0')
+		sum += digit * weights[index%len(weights)]
+	}
+	return sum
+}
+
+// mod11Digit computes the mod 11 check digit with weights 2 to 9 used by
+// Caixa and Santander, which is 0 when 11 minus the remainder exceeds 9
+func mod11Digit(value string) int {
+	digit := 11 - weightedSum(value, []int{2, 3, 4, 5, 6, 7, 8, 9})%11
+	if digit > 9 {
+		return 0
+	}
+	return digit
+}
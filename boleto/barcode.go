@@ -0,0 +1,110 @@
+package boleto
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Every Barcode from Boleto has exactly 44 digits
+const barcodeLength = 44
+
+// Slices of the Barcode that make up each field of the Digitable Line,
+// which is followed by its mod 10 check digit in fields 1 to 3
+var barcodeToDigitableLineFields = []struct {
+	parts      [][2]int
+	hasMod10DV bool
+}{
+	{[][2]int{{0, 4}, {19, 24}}, true},
+	{[][2]int{{24, 34}}, true},
+	{[][2]int{{34, 44}}, true},
+	{[][2]int{{4, 5}}, false},
+	{[][2]int{{5, 19}}, false},
+}
+
+// ToBarcode converts a Digitable Line into the 44-digit Barcode it encodes.
+// Non-digit characters are ignored, as in IsValid.
+// Returns the error reported by Validate if the Digitable Line is invalid.
+func ToBarcode(digitableLine string) (string, error) {
+	digitableLineNumbers := helpers.OnlyNumbers(digitableLine)
+	if err := validate(digitableLineNumbers); err != nil {
+		return "", err
+	}
+
+	if len(digitableLineNumbers) == arrecadacaoLineLength {
+		return parseArrecadacaoLine(digitableLineNumbers), nil
+	}
+
+	return parseDigitableLine(digitableLineNumbers), nil
+}
+
+// FromBarcode converts a 44-digit Barcode into its Digitable Line, computing
+// the check digit of each field. The Digitable Line has 47 digits for bank
+// boletos and 48 digits for arrecadação slips. Non-digit characters are
+// ignored, as in IsValidBarcode.
+// Returns the error reported by ValidateBarcode if the Barcode is invalid.
+func FromBarcode(barcode string) (string, error) {
+	barcodeNumbers := helpers.OnlyNumbers(barcode)
+	if err := validateBarcode(barcodeNumbers); err != nil {
+		return "", err
+	}
+
+	if barcodeNumbers[0] == arrecadacaoProductID {
+		return arrecadacaoLineFromBarcode(barcodeNumbers), nil
+	}
+
+	buf := bytes.Buffer{}
+	for _, field := range barcodeToDigitableLineFields {
+		fieldNumbers := ""
+		for _, part := range field.parts {
+			fieldNumbers += barcodeNumbers[part[0]:part[1]]
+		}
+
+		buf.WriteString(fieldNumbers)
+		if field.hasMod10DV {
+			buf.WriteString(strconv.Itoa(getMod10(fieldNumbers)))
+		}
+	}
+
+	return buf.String(), nil
+}
+
+// IsValidBarcode validates if a given 44-digit Barcode is valid.
+// Non-digit characters are ignored; use ValidateBarcode to reject them.
+func IsValidBarcode(barcode string) bool {
+	return validateBarcode(helpers.OnlyNumbers(barcode)) == nil
+}
+
+// ValidateBarcode validates a given 44-digit Barcode and returns the reason
+// why it is invalid, or nil if it is valid.
+func ValidateBarcode(barcode string) error {
+	if !helpers.ContainsOnly(barcode, allowedCharacters) {
+		return ErrInvalidCharacters
+	}
+
+	return validateBarcode(helpers.OnlyNumbers(barcode))
+}
+
+// validateBarcode checks the digits of a Barcode
+func validateBarcode(barcodeNumbers string) error {
+	if len(barcodeNumbers) != barcodeLength {
+		return ErrInvalidLength
+	}
+	if barcodeNumbers[0] == arrecadacaoProductID {
+		return validateArrecadacaoBarcode(barcodeNumbers)
+	}
+	if !validateBarcodeCheckDigit(barcodeNumbers) {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// validateBarcodeCheckDigit verifies the mod 11 check digit of a Barcode
+func validateBarcodeCheckDigit(barcode string) bool {
+	mod11 := getMod11(barcode[0:checkDigitMod11Position] + barcode[checkDigitMod11Position+1:])
+	mod11Value, _ := strconv.Atoi(fmt.Sprintf("%c", barcode[checkDigitMod11Position]))
+	return mod11Value == mod11
+}
@@ -0,0 +1,256 @@
+// Package barcode renders the 44-digit Barcode of a boleto in the
+// Interleaved 2 of 5 symbology required by FEBRABAN, as an image.Image, a
+// PNG or an SVG.
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/brazilian-utils/go/boleto"
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Errors returned when building the options.
+var (
+	ErrInvalidNarrowWidth = errors.New("barcode: invalid narrow width")
+	ErrInvalidRatio       = errors.New("barcode: wide to narrow ratio must be between 2 and 3")
+	ErrInvalidHeight      = errors.New("barcode: invalid height")
+	ErrInvalidQuietZone   = errors.New("barcode: invalid quiet zone")
+)
+
+// Bar and space widths of each digit: true is a wide element
+var digitPatterns = [10][5]bool{
+	{false, false, true, true, false},
+	{true, false, false, false, true},
+	{false, true, false, false, true},
+	{true, true, false, false, false},
+	{false, false, true, false, true},
+	{true, false, true, false, false},
+	{false, true, true, false, false},
+	{false, false, false, true, true},
+	{true, false, false, true, false},
+	{false, true, false, true, false},
+}
+
+// Start (narrow bar, narrow space, narrow bar, narrow space) and stop (wide
+// bar, narrow space, narrow bar) patterns
+var (
+	startPattern = []bool{false, false, false, false}
+	stopPattern  = []bool{true, false, false}
+)
+
+// Defaults follow the FEBRABAN layout: a 3:1 ratio, a quiet zone of 10
+// narrow elements and a height of about 13 mm for a 0.25 mm narrow element
+const (
+	defaultNarrowWidth = 2
+	defaultRatio       = 3
+	defaultHeight      = 100
+	defaultQuietZone   = 10
+)
+
+// Option configures the rendering.
+type Option func(*options) error
+
+type options struct {
+	narrowWidth int
+	ratio       int
+	height      int
+	quietZone   int
+}
+
+// WithNarrowWidth sets the width of narrow bars and spaces, in pixels.
+func WithNarrowWidth(pixels int) Option {
+	return func(o *options) error {
+		if pixels < 1 {
+			return ErrInvalidNarrowWidth
+		}
+
+		o.narrowWidth = pixels
+		return nil
+	}
+}
+
+// WithRatio sets how many times wide elements are wider than narrow ones.
+// Interleaved 2 of 5 requires a ratio between 2 and 3.
+func WithRatio(ratio int) Option {
+	return func(o *options) error {
+		if ratio < 2 || ratio > 3 {
+			return ErrInvalidRatio
+		}
+
+		o.ratio = ratio
+		return nil
+	}
+}
+
+// WithHeight sets the height of the bars, in pixels.
+func WithHeight(pixels int) Option {
+	return func(o *options) error {
+		if pixels < 1 {
+			return ErrInvalidHeight
+		}
+
+		o.height = pixels
+		return nil
+	}
+}
+
+// WithQuietZone sets the blank margin on each side, in narrow elements.
+// Scanners need at least 10.
+func WithQuietZone(narrowElements int) Option {
+	return func(o *options) error {
+		if narrowElements < 10 {
+			return ErrInvalidQuietZone
+		}
+
+		o.quietZone = narrowElements
+		return nil
+	}
+}
+
+// Encode returns the widths of the bars and spaces encoding a 44-digit
+// Barcode, in narrow elements with a 3:1 ratio and alternating from a bar:
+// the start pattern, each pair of digits with the first one in the bars and
+// the second one in the spaces, and the stop pattern.
+// Returns the error reported by boleto.ValidateBarcode if the Barcode is
+// invalid.
+func Encode(barcode string) ([]int, error) {
+	return encode(barcode, defaultRatio)
+}
+
+func encode(barcode string, ratio int) ([]int, error) {
+	if err := boleto.ValidateBarcode(barcode); err != nil {
+		return nil, err
+	}
+	barcode = helpers.OnlyNumbers(barcode)
+
+	width := func(wide bool) int {
+		if wide {
+			return ratio
+		}
+		return 1
+	}
+
+	widths := make([]int, 0, len(startPattern)+len(barcode)*5+len(stopPattern))
+	for _, wide := range startPattern {
+		widths = append(widths, width(wide))
+	}
+	for index := 0; index < len(barcode); index += 2 {
+		bars := digitPatterns[barcode[index]-'0']
+		spaces := digitPatterns[barcode[index+1]-'0']
+		for element := 0; element < 5; element++ {
+			widths = append(widths, width(bars[element]), width(spaces[element]))
+		}
+	}
+	for _, wide := range stopPattern {
+		widths = append(widths, width(wide))
+	}
+
+	return widths, nil
+}
+
+// Image renders a 44-digit Barcode as a black and white image.
+// Returns the error reported by boleto.ValidateBarcode if the Barcode is
+// invalid, or the error of an invalid option.
+func Image(barcode string, opts ...Option) (image.Image, error) {
+	o, widths, err := prepare(barcode, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewGray(image.Rect(0, 0, o.totalWidth(widths), o.height))
+	for index := range img.Pix {
+		img.Pix[index] = 0xff
+	}
+
+	x := o.quietZone * o.narrowWidth
+	for index, width := range widths {
+		width *= o.narrowWidth
+		if index%2 == 0 {
+			for bx := x; bx < x+width; bx++ {
+				for y := 0; y < o.height; y++ {
+					img.SetGray(bx, y, color.Gray{Y: 0})
+				}
+			}
+		}
+		x += width
+	}
+
+	return img, nil
+}
+
+// WritePNG renders a 44-digit Barcode as a PNG image into w.
+// Returns the errors of Image, or the error writing to w.
+func WritePNG(w io.Writer, barcode string, opts ...Option) error {
+	img, err := Image(barcode, opts...)
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, img)
+}
+
+// WriteSVG renders a 44-digit Barcode as an SVG document into w, with one
+// rectangle per bar.
+// Returns the errors of Image, or the error writing to w.
+func WriteSVG(w io.Writer, barcode string, opts ...Option) error {
+	o, widths, err := prepare(barcode, opts)
+	if err != nil {
+		return err
+	}
+
+	width := o.totalWidth(widths)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, width, o.height, width, o.height)
+	fmt.Fprintf(&svg, `<rect width="%d" height="%d" fill="#fff"/>`, width, o.height)
+
+	x := o.quietZone * o.narrowWidth
+	for index, elementWidth := range widths {
+		elementWidth *= o.narrowWidth
+		if index%2 == 0 {
+			fmt.Fprintf(&svg, `<rect x="%d" width="%d" height="%d"/>`, x, elementWidth, o.height)
+		}
+		x += elementWidth
+	}
+	svg.WriteString("</svg>")
+
+	_, err = io.WriteString(w, svg.String())
+	return err
+}
+
+func prepare(barcode string, opts []Option) (*options, []int, error) {
+	o := &options{
+		narrowWidth: defaultNarrowWidth,
+		ratio:       defaultRatio,
+		height:      defaultHeight,
+		quietZone:   defaultQuietZone,
+	}
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	widths, err := encode(barcode, o.ratio)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return o, widths, nil
+}
+
+// totalWidth returns the width of the symbol and its quiet zones, in pixels
+func (o *options) totalWidth(widths []int) int {
+	total := 2 * o.quietZone
+	for _, width := range widths {
+		total += width
+	}
+	return total * o.narrowWidth
+}
@@ -0,0 +1,127 @@
+package barcode_test
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/boleto"
+	"github.com/brazilian-utils/go/boleto/barcode"
+)
+
+const validBarcode = "00196758600001026560000001149718606852452211"
+
+func TestEncode(t *testing.T) {
+	widths, err := barcode.Encode(validBarcode)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Start, 5 bars and 5 spaces per pair of digits, and stop
+	if expected := 4 + 44*5 + 3; len(widths) != expected {
+		t.Errorf("Expected %v elements, got %v", expected, len(widths))
+	}
+
+	// Digits 0 and 0 are narrow, narrow, wide, wide, narrow in both bars and spaces
+	expected := []int{1, 1, 1, 1, 1, 1, 1, 1, 3, 3, 3, 3, 1, 1}
+	for index, width := range expected {
+		if widths[index] != width {
+			t.Errorf("Failing for element %v \t Expected: %v | Received: %v", index, width, widths[index])
+		}
+	}
+	if stop := widths[len(widths)-3:]; stop[0] != 3 || stop[1] != 1 || stop[2] != 1 {
+		t.Errorf("Unexpected stop pattern: %v", stop)
+	}
+}
+
+var encodeErrorTests = []struct {
+	input    string
+	expected error
+}{
+	{"0019675860000102656000000114971860685245221", boleto.ErrInvalidLength},
+	{"00196758600001026560000001149718606852452212", boleto.ErrInvalidCheckDigit},
+	{"0019675860000102656000000114971860685245221A", boleto.ErrInvalidCharacters},
+}
+
+func TestEncodeErrors(t *testing.T) {
+	for _, table := range encodeErrorTests {
+		if _, err := barcode.Encode(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+func TestImage(t *testing.T) {
+	img, err := barcode.Image(validBarcode, barcode.WithNarrowWidth(1), barcode.WithHeight(50))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 405 narrow elements for the symbol and 10 on each side
+	bounds := img.Bounds()
+	if bounds.Dx() != 425 || bounds.Dy() != 50 {
+		t.Errorf("Unexpected bounds: %v", bounds)
+	}
+	if c := color.GrayModel.Convert(img.At(9, 0)).(color.Gray); c.Y != 0xff {
+		t.Errorf("Expected a white quiet zone, got %v", c)
+	}
+	if c := color.GrayModel.Convert(img.At(10, 49)).(color.Gray); c.Y != 0 {
+		t.Errorf("Expected a black start bar, got %v", c)
+	}
+	if c := color.GrayModel.Convert(img.At(11, 0)).(color.Gray); c.Y != 0xff {
+		t.Errorf("Expected a white start space, got %v", c)
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := barcode.WritePNG(&buf, validBarcode, barcode.WithRatio(2)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// With a 2:1 ratio each digit takes 7 narrow elements
+	if expected := (4 + 44*7 + 4 + 20) * 2; img.Bounds().Dx() != expected {
+		t.Errorf("Expected width %v, got %v", expected, img.Bounds().Dx())
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := barcode.WriteSVG(&buf, validBarcode); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	svg := buf.String()
+	if !strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="850" height="100"`) {
+		t.Errorf("Unexpected SVG header: %v", svg[:80])
+	}
+	if bars := strings.Count(svg, `<rect x=`); bars != 2+44*5/2+2 {
+		t.Errorf("Expected %v bars, got %v", 2+44*5/2+2, bars)
+	}
+}
+
+var optionErrorTests = []struct {
+	option   barcode.Option
+	expected error
+}{
+	{barcode.WithNarrowWidth(0), barcode.ErrInvalidNarrowWidth},
+	{barcode.WithRatio(4), barcode.ErrInvalidRatio},
+	{barcode.WithHeight(0), barcode.ErrInvalidHeight},
+	{barcode.WithQuietZone(5), barcode.ErrInvalidQuietZone},
+}
+
+func TestOptionErrors(t *testing.T) {
+	for _, table := range optionErrorTests {
+		if _, err := barcode.Image(validBarcode, table.option); !errors.Is(err, table.expected) {
+			t.Errorf("Expected: %v | Received: %v", table.expected, err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package boleto_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/boleto"
+)
+
+const (
+	validDigitableLine = "00190000090114971860168524522114675860000102656"
+	validBarcode       = "00196758600001026560000001149718606852452211"
+)
+
+func TestToBarcode(t *testing.T) {
+	barcode, err := boleto.ToBarcode("0019000009 01149.718601 68524.522114 6 75860000102656")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if barcode != validBarcode {
+		t.Errorf("Expected %v, got %v", validBarcode, barcode)
+	}
+
+	if _, err := boleto.ToBarcode("00190000020114971860168524522114675860000102656"); !errors.Is(err, boleto.ErrInvalidFieldCheckDigit) {
+		t.Errorf("Expected ErrInvalidFieldCheckDigit, got %v", err)
+	}
+}
+
+func TestFromBarcode(t *testing.T) {
+	digitableLine, err := boleto.FromBarcode(validBarcode)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if digitableLine != validDigitableLine {
+		t.Errorf("Expected %v, got %v", validDigitableLine, digitableLine)
+	}
+
+	if _, err := boleto.FromBarcode("00196758600001026560000001149718606852452212"); !errors.Is(err, boleto.ErrInvalidCheckDigit) {
+		t.Errorf("Expected ErrInvalidCheckDigit, got %v", err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	barcode, _ := boleto.ToBarcode(validDigitableLine)
+	digitableLine, _ := boleto.FromBarcode(barcode)
+	if digitableLine != validDigitableLine {
+		t.Errorf("Expected %v, got %v", validDigitableLine, digitableLine)
+	}
+}
+
+func TestConversionIgnoresSymbols(t *testing.T) {
+	digitableLine := "0019000009_01149.718601_68524.522114_6_75860000102656"
+	if !boleto.IsValid(digitableLine) {
+		t.Fatalf("Expected %v to be valid", digitableLine)
+	}
+	if barcode, err := boleto.ToBarcode(digitableLine); barcode != validBarcode || err != nil {
+		t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", digitableLine, validBarcode, barcode, err)
+	}
+
+	barcode := "0019_6758600001026560000001149718606852452211"
+	if !boleto.IsValidBarcode(barcode) {
+		t.Fatalf("Expected %v to be valid", barcode)
+	}
+	if err := boleto.ValidateBarcode(barcode); !errors.Is(err, boleto.ErrInvalidCharacters) {
+		t.Errorf("Failing for %v \t Expected: %v | Received: %v", barcode, boleto.ErrInvalidCharacters, err)
+	}
+	if res, err := boleto.FromBarcode(barcode); res != validDigitableLine || err != nil {
+		t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", barcode, validDigitableLine, res, err)
+	}
+}
+
+var barcodeTests = []struct {
+	input    string
+	expected error
+}{
+	{validBarcode, nil},
+	{"", boleto.ErrInvalidLength},
+	{"0019675860000102656000000114971860685245221", boleto.ErrInvalidLength},
+	{"0019675860000102656000000114971860685245221a", boleto.ErrInvalidCharacters},
+	{"00196758600001026560000001149718606852452212", boleto.ErrInvalidCheckDigit},
+}
+
+func TestValidateBarcode(t *testing.T) {
+	for _, table := range barcodeTests {
+		if err := boleto.ValidateBarcode(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+		if res := boleto.IsValidBarcode(table.input); res != (table.expected == nil) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected == nil, res)
+		}
+	}
+}
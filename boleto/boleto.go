@@ -0,0 +1,115 @@
+package boleto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Boleto holds the data encoded in a bank boleto.
+type Boleto struct {
+	// BankCode is the 3-digit code of the issuing bank (e.g. "001").
+	BankCode string
+	// CurrencyCode is the currency digit; "9" stands for Real.
+	CurrencyCode string
+	// DueDate is the due date, or the zero time when the boleto has none.
+	DueDate time.Time
+	// Amount is the amount in centavos; 0 when the payer fills it in.
+	Amount int64
+	// FreeField is the 25-digit bank-specific field (campo livre).
+	FreeField string
+	// Barcode is the 44-digit Barcode.
+	Barcode string
+	// DigitableLine is the 47-digit Digitable Line.
+	DigitableLine string
+}
+
+// Base date of the due date factor: factor 1000 is 2000-07-03
+var dueDateBase = time.Date(1997, time.October, 7, 0, 0, 0, 0, time.UTC)
+
+// The due date factor restarts at 1000 after reaching 9999 on 2025-02-21,
+// so equal factors are 9000 days apart
+const dueDateFactorCycle = 9000
+
+// now returns the reference date used to pick the due date factor cycle.
+// It is a variable so tests can override it.
+var now = time.Now
+
+// Slices of the Barcode holding each field
+var (
+	bankCodePosition      = [2]int{0, 3}
+	currencyCodePosition  = [2]int{3, 4}
+	dueDateFactorPosition = [2]int{5, 9}
+	amountPosition        = [2]int{9, 19}
+	freeFieldPosition     = [2]int{19, 44}
+)
+
+// Parse decodes the 47-digit Digitable Line of a bank boleto. Non-digit
+// characters are ignored, as in IsValid.
+// Returns the error reported by Validate if the Digitable Line is invalid,
+// or ErrInvalidKind if it is an arrecadação slip.
+func Parse(digitableLine string) (*Boleto, error) {
+	barcode, err := ToBarcode(digitableLine)
+	if err != nil {
+		return nil, err
+	}
+	if IsArrecadacao(barcode) {
+		return nil, ErrInvalidKind
+	}
+
+	return parseBarcode(barcode, helpers.OnlyNumbers(digitableLine)), nil
+}
+
+// ParseBarcode decodes the 44-digit Barcode of a bank boleto. Non-digit
+// characters are ignored, as in IsValidBarcode.
+// Returns the error reported by ValidateBarcode if the Barcode is invalid,
+// or ErrInvalidKind if it is an arrecadação slip.
+func ParseBarcode(barcode string) (*Boleto, error) {
+	digitableLine, err := FromBarcode(barcode)
+	if err != nil {
+		return nil, err
+	}
+	if IsArrecadacao(barcode) {
+		return nil, ErrInvalidKind
+	}
+
+	return parseBarcode(helpers.OnlyNumbers(barcode), digitableLine), nil
+}
+
+func parseBarcode(barcode string, digitableLine string) *Boleto {
+	factor, _ := strconv.Atoi(field(barcode, dueDateFactorPosition))
+	amount, _ := strconv.ParseInt(field(barcode, amountPosition), 10, 64)
+
+	return &Boleto{
+		BankCode:      field(barcode, bankCodePosition),
+		CurrencyCode:  field(barcode, currencyCodePosition),
+		DueDate:       dueDate(factor, now()),
+		Amount:        amount,
+		FreeField:     field(barcode, freeFieldPosition),
+		Barcode:       barcode,
+		DigitableLine: digitableLine,
+	}
+}
+
+// dueDate converts a due date factor into a date. Since the factor restarts
+// every 9000 days, the cycle whose date is closest to reference is chosen.
+// Factor 0 means the boleto has no due date.
+func dueDate(factor int, reference time.Time) time.Time {
+	if factor == 0 {
+		return time.Time{}
+	}
+
+	date := dueDateBase.AddDate(0, 0, factor)
+	for {
+		next := date.AddDate(0, 0, dueDateFactorCycle)
+		if next.Sub(reference).Abs() >= date.Sub(reference).Abs() {
+			return date
+		}
+		date = next
+	}
+}
+
+func field(barcode string, position [2]int) string {
+	return barcode[position[0]:position[1]]
+}
@@ -0,0 +1,46 @@
+package boleto_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brazilian-utils/go/boleto"
+)
+
+func TestParse(t *testing.T) {
+	// Factor 7586 is 2018-07-15 only while the first cycle is current
+	t.Cleanup(boleto.SetNow(func() time.Time { return time.Date(2018, time.July, 1, 0, 0, 0, 0, time.UTC) }))
+
+	parsed, err := boleto.Parse("0019000009 01149.718601 68524.522114 6 75860000102656")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := boleto.Boleto{
+		BankCode:      "001",
+		CurrencyCode:  "9",
+		DueDate:       time.Date(2018, time.July, 15, 0, 0, 0, 0, time.UTC),
+		Amount:        102656,
+		FreeField:     "0000001149718606852452211",
+		Barcode:       validBarcode,
+		DigitableLine: validDigitableLine,
+	}
+	if *parsed != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *parsed)
+	}
+
+	if _, err := boleto.Parse("000111"); !errors.Is(err, boleto.ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got %v", err)
+	}
+}
+
+func TestParseBarcode(t *testing.T) {
+	parsed, err := boleto.ParseBarcode(validBarcode)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if parsed.DigitableLine != validDigitableLine || parsed.Amount != 102656 || parsed.BankCode != "001" {
+		t.Errorf("Unexpected boleto: %+v", *parsed)
+	}
+}
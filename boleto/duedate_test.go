@@ -0,0 +1,70 @@
+package boleto
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var dueDateTests = []struct {
+	factor    int
+	reference time.Time
+	expected  time.Time
+}{
+	{0, date(2026, time.January, 1), time.Time{}},
+	{1000, date(2000, time.July, 1), date(2000, time.July, 3)},
+	{7586, date(2018, time.July, 1), date(2018, time.July, 15)},
+	{9999, date(2025, time.February, 1), date(2025, time.February, 21)},
+	{1000, date(2025, time.February, 1), date(2025, time.February, 22)},
+	{1000, date(2026, time.October, 17), date(2025, time.February, 22)},
+	{1600, date(2026, time.October, 17), date(2026, time.October, 15)},
+}
+
+func TestDueDate(t *testing.T) {
+	for _, table := range dueDateTests {
+		if res := dueDate(table.factor, table.reference); !res.Equal(table.expected) {
+			t.Errorf("Failing for %v at %v \t Expected: %v | Received: %v", table.factor, table.reference, table.expected, res)
+		}
+	}
+}
+
+func TestParseDueDateCycle(t *testing.T) {
+	// Factor 7586 is 2018-07-15 in the first cycle and 2043-03-06 in the second
+	t.Cleanup(SetNow(func() time.Time { return date(2040, time.January, 1) }))
+
+	parsed, err := Parse("00190000090114971860168524522114675860000102656")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := date(2043, time.March, 6); !parsed.DueDate.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, parsed.DueDate)
+	}
+}
+
+var dueDateFactorTests = []struct {
+	date     time.Time
+	expected int
+}{
+	{time.Time{}, 0},
+	{date(2000, time.July, 3), 1000},
+	{date(2018, time.July, 15), 7586},
+	{date(2025, time.February, 21), 9999},
+	{date(2025, time.February, 22), 1000},
+	{date(2026, time.October, 15), 1600},
+	{time.Date(2026, time.October, 15, 23, 59, 0, 0, time.FixedZone("BRT", -3*60*60)), 1600},
+}
+
+func TestDueDateFactor(t *testing.T) {
+	for _, table := range dueDateFactorTests {
+		if res, err := dueDateFactor(table.date); res != table.expected || err != nil {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.date, table.expected, res, err)
+		}
+	}
+
+	if _, err := dueDateFactor(dueDateBase); err != ErrInvalidDueDate {
+		t.Errorf("Expected ErrInvalidDueDate, got %v", err)
+	}
+}
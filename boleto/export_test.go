@@ -0,0 +1,11 @@
+package boleto
+
+import "time"
+
+// SetNow replaces the clock Parse uses to pick the due date factor cycle and
+// returns a function that restores it.
+func SetNow(clock func() time.Time) (restore func()) {
+	old := now
+	now = clock
+	return func() { now = old }
+}
@@ -0,0 +1,116 @@
+package boleto
+
+import (
+	"errors"
+	"sync"
+)
+
+// Errors returned when decoding the free field of a bank boleto.
+var (
+	ErrUnsupportedBank                = errors.New("boleto: no free field decoder for bank")
+	ErrInvalidNossoNumeroCheckDigit   = errors.New("boleto: invalid nosso número check digit")
+	ErrInvalidFreeFieldCheckDigit     = errors.New("boleto: invalid free field check digit")
+	ErrInvalidBeneficiaryCheckDigit   = errors.New("boleto: invalid beneficiary check digit")
+	ErrInvalidAgencyAccountCheckDigit = errors.New("boleto: invalid agency and account check digit")
+	ErrUnknownFreeFieldLayout         = errors.New("boleto: cannot determine the free field layout")
+)
+
+// FreeField holds the bank-specific data encoded in the 25-digit free field
+// (campo livre) of a bank boleto. Fields a bank does not encode are empty.
+type FreeField struct {
+	// Agency is the beneficiary's bank branch.
+	Agency string
+	// Account is the beneficiary's account, without its check digit.
+	Account string
+	// Carteira is the bank's collection portfolio.
+	Carteira string
+	// Beneficiary is the beneficiary (cedente) code or convênio.
+	Beneficiary string
+	// NossoNumero is the bank's identifier of the boleto, without its check
+	// digit.
+	NossoNumero string
+	// NossoNumeroCheckDigit is the check digit of NossoNumero when the bank
+	// encodes it in the free field.
+	NossoNumeroCheckDigit string
+}
+
+// FreeFieldDecoder decodes the free field layout of a bank.
+type FreeFieldDecoder interface {
+	// Decode splits a 25-digit free field into its fields, verifying the
+	// check digits it contains.
+	Decode(freeField string) (*FreeField, error)
+	// NossoNumeroCheckDigit computes the check digit of the nosso número,
+	// which some banks print on the boleto but leave out of the free field.
+	NossoNumeroCheckDigit(freeField *FreeField) string
+}
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = map[string]FreeFieldDecoder{
+		"001": BancoDoBrasilDecoder{},
+		"033": SantanderDecoder{},
+		"104": CaixaDecoder{},
+		"237": BradescoDecoder{},
+		"341": ItauDecoder{},
+		"756": SicoobDecoder{},
+	}
+)
+
+// RegisterFreeFieldDecoder sets the decoder used for the given 3-digit bank
+// code, replacing the built-in one if any.
+func RegisterFreeFieldDecoder(bankCode string, decoder FreeFieldDecoder) {
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+
+	decoders[bankCode] = decoder
+}
+
+// FreeFieldDecoderFor returns the decoder registered for the given 3-digit
+// bank code.
+func FreeFieldDecoderFor(bankCode string) (FreeFieldDecoder, bool) {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+
+	decoder, ok := decoders[bankCode]
+	return decoder, ok
+}
+
+// DecodeFreeField decodes the free field with the decoder registered for the
+// boleto's bank.
+// Returns ErrUnsupportedBank if there is none, or the decoder's error.
+func (b *Boleto) DecodeFreeField() (*FreeField, error) {
+	decoder, ok := FreeFieldDecoderFor(b.BankCode)
+	if !ok {
+		return nil, ErrUnsupportedBank
+	}
+
+	return decoder.Decode(b.FreeField)
+}
+
+// ValidateNossoNumero reports whether checkDigit, usually printed next to the
+// nosso número on the boleto, matches the one computed by the decoder
+// registered for the boleto's bank.
+// Returns ErrUnsupportedBank if there is none, or the decoder's error.
+func (b *Boleto) ValidateNossoNumero(checkDigit string) error {
+	decoder, ok := FreeFieldDecoderFor(b.BankCode)
+	if !ok {
+		return ErrUnsupportedBank
+	}
+
+	freeField, err := decoder.Decode(b.FreeField)
+	if err != nil {
+		return err
+	}
+	if decoder.NossoNumeroCheckDigit(freeField) != checkDigit {
+		return ErrInvalidNossoNumeroCheckDigit
+	}
+
+	return nil
+}
+
+func checkFreeField(freeField string) error {
+	if !isDigits(freeField, freeFieldPosition[1]-freeFieldPosition[0]) {
+		return ErrInvalidFreeField
+	}
+	return nil
+}
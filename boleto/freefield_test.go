@@ -0,0 +1,146 @@
+package boleto_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brazilian-utils/go/boleto"
+)
+
+var decodeFreeFieldTests = []struct {
+	bankCode    string
+	freeField   string
+	expected    boleto.FreeField
+	nossoNumero string
+}{
+	{"001", "0000001234567000000000117", boleto.FreeField{Beneficiary: "1234567", NossoNumero: "12345670000000001", Carteira: "17"}, ""},
+	{"001", "1234560000000000000000121", boleto.FreeField{Beneficiary: "123456", NossoNumero: "00000000000000001"}, ""},
+	{"001", "1234560000100570001234518", boleto.FreeField{NossoNumero: "12345600001", Agency: "0057", Account: "00012345", Carteira: "18"}, "7"},
+	{"033", "9123456756661245780020101", boleto.FreeField{Beneficiary: "1234567", NossoNumero: "566612457800", NossoNumeroCheckDigit: "2", Carteira: "101"}, "2"},
+	{"104", "0055077000100040000001235", boleto.FreeField{Beneficiary: "005507", Carteira: "1", NossoNumero: "14000000000000123"}, "1"},
+	{"237", "0057190000000000200123450", boleto.FreeField{Agency: "0057", Carteira: "19", NossoNumero: "00000000002", Account: "0012345"}, "8"},
+	{"341", "1101234567880057123457000", boleto.FreeField{Carteira: "110", NossoNumero: "12345678", NossoNumeroCheckDigit: "8", Agency: "0057", Account: "12345"}, "8"},
+	{"341", "1261234567850057123457000", boleto.FreeField{Carteira: "126", NossoNumero: "12345678", NossoNumeroCheckDigit: "5", Agency: "0057", Account: "12345"}, "5"},
+	{"756", "1413301012345600000105001", boleto.FreeField{Carteira: "1", Agency: "4133", Beneficiary: "0123456", NossoNumero: "0000010", NossoNumeroCheckDigit: "5"}, "5"},
+}
+
+func TestDecodeFreeField(t *testing.T) {
+	for _, table := range decodeFreeFieldTests {
+		decoder, ok := boleto.FreeFieldDecoderFor(table.bankCode)
+		if !ok {
+			t.Fatalf("Failing for %v \t No decoder", table.bankCode)
+		}
+
+		decoded, err := decoder.Decode(table.freeField)
+		if err != nil {
+			t.Fatalf("Failing for %v \t Unexpected error: %v", table.freeField, err)
+		}
+		if *decoded != table.expected {
+			t.Errorf("Failing for %v \t Expected: %+v | Received: %+v", table.freeField, table.expected, *decoded)
+		}
+		if res := decoder.NossoNumeroCheckDigit(decoded); res != table.nossoNumero {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.freeField, table.nossoNumero, res)
+		}
+	}
+}
+
+var decodeFreeFieldErrorTests = []struct {
+	bankCode  string
+	freeField string
+	expected  error
+}{
+	{"001", "123", boleto.ErrInvalidFreeField},
+	{"001", "0000000123456700000000117", boleto.ErrUnknownFreeFieldLayout},
+	{"001", "0123450000000000000000121", boleto.ErrUnknownFreeFieldLayout},
+	{"001", "0123000000100570001234518", boleto.ErrUnknownFreeFieldLayout},
+	{"033", "8123456756661245780020101", boleto.ErrInvalidFreeField},
+	{"033", "9123456756661245780030101", boleto.ErrInvalidNossoNumeroCheckDigit},
+	{"104", "0055078000100040000001235", boleto.ErrInvalidBeneficiaryCheckDigit},
+	{"104", "0055077000100040000001236", boleto.ErrInvalidFreeFieldCheckDigit},
+	{"341", "1101234567870057123457000", boleto.ErrInvalidNossoNumeroCheckDigit},
+	{"341", "1101234567880057123458000", boleto.ErrInvalidAgencyAccountCheckDigit},
+	{"756", "1413301012345600000106001", boleto.ErrInvalidNossoNumeroCheckDigit},
+}
+
+func TestDecodeFreeFieldErrors(t *testing.T) {
+	for _, table := range decodeFreeFieldErrorTests {
+		decoder, _ := boleto.FreeFieldDecoderFor(table.bankCode)
+		if _, err := decoder.Decode(table.freeField); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.freeField, table.expected, err)
+		}
+	}
+}
+
+var bancoDoBrasilTests = []struct {
+	agreementLength int
+	freeField       string
+	expected        *boleto.FreeField
+}{
+	{4, "0123000000100570001234518", &boleto.FreeField{NossoNumero: "01230000001", Agency: "0057", Account: "00012345", Carteira: "18"}},
+	{6, "1234560000100570001234518", &boleto.FreeField{NossoNumero: "12345600001", Agency: "0057", Account: "00012345", Carteira: "18"}},
+	{6, "1234560000000000000000121", &boleto.FreeField{Beneficiary: "123456", NossoNumero: "00000000000000001"}},
+	{7, "0000001234567000000000117", &boleto.FreeField{Beneficiary: "1234567", NossoNumero: "12345670000000001", Carteira: "17"}},
+	{4, "1234560000000000000000121", nil},
+	{7, "1234560000100570001234518", nil},
+	{5, "1234560000100570001234518", nil},
+}
+
+func TestBancoDoBrasilAgreementLength(t *testing.T) {
+	for _, table := range bancoDoBrasilTests {
+		decoded, err := boleto.BancoDoBrasilDecoder{AgreementLength: table.agreementLength}.Decode(table.freeField)
+		if table.expected == nil {
+			if !errors.Is(err, boleto.ErrUnknownFreeFieldLayout) {
+				t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.freeField, boleto.ErrUnknownFreeFieldLayout, err)
+			}
+			continue
+		}
+		if err != nil || *decoded != *table.expected {
+			t.Errorf("Failing for %v \t Expected: %+v | Received: %+v (%v)", table.freeField, *table.expected, decoded, err)
+		}
+	}
+}
+
+func TestBoletoDecodeFreeField(t *testing.T) {
+	digitableLine, _, _ := boleto.Generate("341", 10000, time.Time{}, "1101234567880057123457000")
+	parsed, err := boleto.Parse(digitableLine)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	decoded, err := parsed.DecodeFreeField()
+	if err != nil || decoded.NossoNumero != "12345678" {
+		t.Errorf("Unexpected free field: %+v (%v)", decoded, err)
+	}
+	if err := parsed.ValidateNossoNumero("8"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := parsed.ValidateNossoNumero("7"); !errors.Is(err, boleto.ErrInvalidNossoNumeroCheckDigit) {
+		t.Errorf("Expected ErrInvalidNossoNumeroCheckDigit, got %v", err)
+	}
+
+	unsupported := boleto.Boleto{BankCode: "999", FreeField: "0000000000000000000000000"}
+	if _, err := unsupported.DecodeFreeField(); !errors.Is(err, boleto.ErrUnsupportedBank) {
+		t.Errorf("Expected ErrUnsupportedBank, got %v", err)
+	}
+}
+
+type fixedDecoder struct{}
+
+func (fixedDecoder) Decode(freeField string) (*boleto.FreeField, error) {
+	return &boleto.FreeField{NossoNumero: freeField}, nil
+}
+
+func (fixedDecoder) NossoNumeroCheckDigit(*boleto.FreeField) string {
+	return "0"
+}
+
+func TestRegisterFreeFieldDecoder(t *testing.T) {
+	boleto.RegisterFreeFieldDecoder("998", fixedDecoder{})
+
+	custom := boleto.Boleto{BankCode: "998", FreeField: "1234"}
+	decoded, err := custom.DecodeFreeField()
+	if err != nil || decoded.NossoNumero != "1234" {
+		t.Errorf("Unexpected free field: %+v (%v)", decoded, err)
+	}
+}
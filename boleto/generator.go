@@ -0,0 +1,169 @@
+package boleto
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Errors returned by Generate.
+var (
+	ErrInvalidIssuer    = errors.New("boleto: invalid bank code or company ID")
+	ErrInvalidAmount    = errors.New("boleto: invalid amount")
+	ErrInvalidDueDate   = errors.New("boleto: invalid due date")
+	ErrInvalidFreeField = errors.New("boleto: invalid free field")
+	ErrInvalidSegment   = errors.New("boleto: invalid segment")
+)
+
+// Currency code of the Real in bank boletos
+const realCurrencyCode = "9"
+
+// Largest amounts, in centavos, that fit in the amount field of bank boletos
+// (10 digits) and arrecadação slips (11 digits)
+const (
+	maxAmount            = 9999999999
+	maxArrecadacaoAmount = 99999999999
+)
+
+// Due date factors have 4 digits, so after 9999 they restart at 1000
+const maxDueDateFactor = 9999
+
+// Option configures Generate.
+type Option func(*generateOptions) error
+
+type generateOptions struct {
+	arrecadacao     bool
+	segment         int
+	valueIdentifier int
+}
+
+// WithArrecadacao makes Generate build a 48-digit arrecadação slip of the
+// given segment (e.g. 3 for energy and gas) and value identifier (6 or 8 for
+// an effective amount, 7 or 9 for a reference amount).
+func WithArrecadacao(segment int, valueIdentifier int) Option {
+	return func(o *generateOptions) error {
+		if _, ok := segmentNames[segment]; !ok {
+			return ErrInvalidSegment
+		}
+		if valueIdentifier < 6 || valueIdentifier > 9 {
+			return ErrInvalidValueIdentifier
+		}
+
+		o.arrecadacao = true
+		o.segment = segment
+		o.valueIdentifier = valueIdentifier
+		return nil
+	}
+}
+
+// Generate builds a Digitable Line and the matching 44-digit Barcode, with
+// all check digits computed. It is meant for test fixtures.
+//
+// By default it builds a 47-digit bank boleto: issuer is the 3-digit bank
+// code, which cannot start with 8, amount is in centavos, dueDate is encoded
+// as the due date factor (the zero time means no due date) and freeField has
+// 25 digits.
+//
+// With WithArrecadacao it builds a 48-digit arrecadação slip: issuer is the
+// 4-digit company ID, or the 8-digit CNPJ root for segment 6, and freeField
+// fills the remaining 25 or 21 digits. Arrecadação slips have no due date
+// field, so dueDate must be the zero time; it usually goes in freeField.
+func Generate(issuer string, amount int64, dueDate time.Time, freeField string, options ...Option) (digitableLine string, barcode string, err error) {
+	var o generateOptions
+	for _, option := range options {
+		if err := option(&o); err != nil {
+			return "", "", err
+		}
+	}
+
+	if o.arrecadacao {
+		barcode, err = generateArrecadacaoBarcode(o, issuer, amount, dueDate, freeField)
+	} else {
+		barcode, err = generateBarcode(issuer, amount, dueDate, freeField)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	digitableLine, err = FromBarcode(barcode)
+	if err != nil {
+		return "", "", err
+	}
+
+	return digitableLine, barcode, nil
+}
+
+func generateBarcode(bankCode string, amount int64, dueDate time.Time, freeField string) (string, error) {
+	// Barcodes starting with 8 are read as arrecadação slips
+	if !isDigits(bankCode, bankCodePosition[1]-bankCodePosition[0]) || bankCode[0] == arrecadacaoProductID {
+		return "", ErrInvalidIssuer
+	}
+	if amount < 0 || amount > maxAmount {
+		return "", ErrInvalidAmount
+	}
+	if !isDigits(freeField, freeFieldPosition[1]-freeFieldPosition[0]) {
+		return "", ErrInvalidFreeField
+	}
+
+	factor, err := dueDateFactor(dueDate)
+	if err != nil {
+		return "", err
+	}
+
+	withoutCheckDigit := fmt.Sprintf("%s%s%04d%010d%s", bankCode, realCurrencyCode, factor, amount, freeField)
+	checkDigit := getMod11(withoutCheckDigit)
+
+	return withoutCheckDigit[:checkDigitMod11Position] + strconv.Itoa(checkDigit) + withoutCheckDigit[checkDigitMod11Position:], nil
+}
+
+func generateArrecadacaoBarcode(o generateOptions, companyID string, amount int64, dueDate time.Time, freeField string) (string, error) {
+	companyIDPosition := companyIDPosition
+	if o.segment == cnpjSegment {
+		companyIDPosition = cnpjRootPosition
+	}
+
+	if !isDigits(companyID, companyIDPosition[1]-companyIDPosition[0]) {
+		return "", ErrInvalidIssuer
+	}
+	if amount < 0 || amount > maxArrecadacaoAmount {
+		return "", ErrInvalidAmount
+	}
+	if !dueDate.IsZero() {
+		return "", ErrInvalidDueDate
+	}
+	if !isDigits(freeField, barcodeLength-companyIDPosition[1]) {
+		return "", ErrInvalidFreeField
+	}
+
+	withoutCheckDigit := fmt.Sprintf("%c%d%d%011d%s%s", arrecadacaoProductID, o.segment, o.valueIdentifier, amount, companyID, freeField)
+	checkDigit, _ := arrecadacaoCheckDigitFunc(withoutCheckDigit)
+
+	return withoutCheckDigit[:arrecadacaoCheckDigitPosition] + strconv.Itoa(checkDigit(withoutCheckDigit)) + withoutCheckDigit[arrecadacaoCheckDigitPosition:], nil
+}
+
+// dueDateFactor converts a due date into its factor, the inverse of dueDate.
+// Returns 0 for the zero time.
+func dueDateFactor(date time.Time) (int, error) {
+	if date.IsZero() {
+		return 0, nil
+	}
+
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	factor := int(date.Sub(dueDateBase).Hours() / 24)
+	if factor < 1 {
+		return 0, ErrInvalidDueDate
+	}
+
+	for factor > maxDueDateFactor {
+		factor -= dueDateFactorCycle
+	}
+
+	return factor, nil
+}
+
+func isDigits(value string, length int) bool {
+	return len(value) == length && helpers.OnlyNumbers(value) == value
+}
@@ -0,0 +1,86 @@
+package boleto_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brazilian-utils/go/boleto"
+)
+
+var generateTests = []struct {
+	issuer        string
+	amount        int64
+	dueDate       time.Time
+	freeField     string
+	options       []boleto.Option
+	digitableLine string
+	barcode       string
+}{
+	{"001", 102656, time.Date(2018, time.July, 15, 0, 0, 0, 0, time.UTC), "0000001149718606852452211", nil, validDigitableLine, validBarcode},
+	{"0024", 14359, time.Time{}, "0200240500024384221010811", []boleto.Option{boleto.WithArrecadacao(4, 6)}, validArrecadacaoLine, validArrecadacaoBarcode},
+	{"60391947", 12345, time.Time{}, "202610170000000000001", []boleto.Option{boleto.WithArrecadacao(6, 8)}, mod11ArrecadacaoLine, mod11ArrecadacaoBarcode},
+}
+
+func TestGenerate(t *testing.T) {
+	for _, table := range generateTests {
+		digitableLine, barcode, err := boleto.Generate(table.issuer, table.amount, table.dueDate, table.freeField, table.options...)
+		if err != nil {
+			t.Fatalf("Failing for %v \t Unexpected error: %v", table.issuer, err)
+		}
+		if digitableLine != table.digitableLine {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.issuer, table.digitableLine, digitableLine)
+		}
+		if barcode != table.barcode {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.issuer, table.barcode, barcode)
+		}
+	}
+}
+
+func TestGenerateRoundTrip(t *testing.T) {
+	dueDate := time.Date(2026, time.October, 30, 0, 0, 0, 0, time.UTC)
+	t.Cleanup(boleto.SetNow(func() time.Time { return time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC) }))
+
+	digitableLine, _, err := boleto.Generate("341", 150000, dueDate, "1090000012345678901234560")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	parsed, err := boleto.Parse(digitableLine)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if parsed.BankCode != "341" || parsed.Amount != 150000 || !parsed.DueDate.Equal(dueDate) || parsed.FreeField != "1090000012345678901234560" {
+		t.Errorf("Unexpected boleto: %+v", *parsed)
+	}
+}
+
+var generateErrorTests = []struct {
+	issuer    string
+	amount    int64
+	dueDate   time.Time
+	freeField string
+	options   []boleto.Option
+	expected  error
+}{
+	{"01", 100, time.Time{}, "0000000000000000000000000", nil, boleto.ErrInvalidIssuer},
+	{"800", 100, time.Time{}, "0000000000000000000000000", nil, boleto.ErrInvalidIssuer},
+	{"0A1", 100, time.Time{}, "0000000000000000000000000", nil, boleto.ErrInvalidIssuer},
+	{"001", -1, time.Time{}, "0000000000000000000000000", nil, boleto.ErrInvalidAmount},
+	{"001", 10000000000, time.Time{}, "0000000000000000000000000", nil, boleto.ErrInvalidAmount},
+	{"001", 100, time.Date(1997, time.October, 1, 0, 0, 0, 0, time.UTC), "0000000000000000000000000", nil, boleto.ErrInvalidDueDate},
+	{"001", 100, time.Time{}, "000000000000000000000000", nil, boleto.ErrInvalidFreeField},
+	{"0024", 100, time.Time{}, "0000000000000000000000000", []boleto.Option{boleto.WithArrecadacao(8, 6)}, boleto.ErrInvalidSegment},
+	{"0024", 100, time.Time{}, "000000000000000000000", []boleto.Option{boleto.WithArrecadacao(4, 5)}, boleto.ErrInvalidValueIdentifier},
+	{"60391947", 100, time.Time{}, "000000000000000000000", []boleto.Option{boleto.WithArrecadacao(4, 6)}, boleto.ErrInvalidIssuer},
+	{"0024", 100, time.Now(), "000000000000000000000", []boleto.Option{boleto.WithArrecadacao(4, 6)}, boleto.ErrInvalidDueDate},
+	{"60391947", 100, time.Time{}, "0000000000000000000000000", []boleto.Option{boleto.WithArrecadacao(6, 6)}, boleto.ErrInvalidFreeField},
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for _, table := range generateErrorTests {
+		if _, _, err := boleto.Generate(table.issuer, table.amount, table.dueDate, table.freeField, table.options...); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.issuer, table.expected, err)
+		}
+	}
+}
@@ -2,12 +2,24 @@ package boleto
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/brazilian-utils/go/helpers"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength          = errors.New("boleto: invalid length")
+	ErrInvalidCharacters      = errors.New("boleto: invalid characters")
+	ErrInvalidFieldCheckDigit = errors.New("boleto: invalid field check digit")
+	ErrInvalidCheckDigit      = errors.New("boleto: invalid check digit")
+)
+
+// Digits and the formatting symbols accepted in a Digitable Line
+const allowedCharacters = "0123456789.- "
+
 // Every Digitable Line from Boleto has exactly 47 characters
 const digitableLineLength = 47
 
@@ -42,17 +54,49 @@ var mod11Weights = struct {
 	increment int
 }{2, 9, 1}
 
-// IsValid validates if a given Digitable Line is valid
+// IsValid validates if a given Digitable Line is valid.
+// Non-digit characters are ignored; use Validate to reject them.
 func IsValid(digitableLine string) bool {
-	digitableLineNumbers := helpers.OnlyNumbers(digitableLine)
+	return validate(helpers.OnlyNumbers(digitableLine)) == nil
+}
+
+// Validate validates a given Digitable Line and returns the reason why it is
+// invalid, or nil if it is valid. Both 47-digit bank boletos and 48-digit
+// arrecadação slips, which start with 8, are accepted.
+func Validate(digitableLine string) error {
+	if !helpers.ContainsOnly(digitableLine, allowedCharacters) {
+		return ErrInvalidCharacters
+	}
+
+	return validate(helpers.OnlyNumbers(digitableLine))
+}
+
+// validate checks the digitable line once it has been reduced to its significant
+// characters, without looking at the symbols that were stripped from it.
+func validate(digitableLineNumbers string) error {
+	if len(digitableLineNumbers) == arrecadacaoLineLength && digitableLineNumbers[0] == arrecadacaoProductID {
+		return validateArrecadacaoLine(digitableLineNumbers)
+	}
 
 	if !isValidLength(digitableLineNumbers) {
-		return false
+		return ErrInvalidLength
 	}
 	if !validateDigitableLinePartials(digitableLineNumbers) {
-		return false
+		return ErrInvalidFieldCheckDigit
 	}
-	return validateMod11CheckDigit(digitableLineNumbers)
+	if !validateMod11CheckDigit(digitableLineNumbers) {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// Validator implements the brutils.Validator interface for Digitable Lines using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
 }
 
 // validates the string length
@@ -99,10 +143,7 @@ func getMod10(partial string) int {
 }
 
 func validateMod11CheckDigit(digitableLine string) bool {
-	parsedDigitableLine := parseDigitableLine(digitableLine)
-	mod11 := getMod11(parsedDigitableLine[0:checkDigitMod11Position] + parsedDigitableLine[checkDigitMod11Position+1:])
-	mod11Value, _ := strconv.Atoi(fmt.Sprintf("%c", parsedDigitableLine[checkDigitMod11Position]))
-	return mod11Value == mod11
+	return validateBarcodeCheckDigit(parseDigitableLine(digitableLine))
 }
 
 func parseDigitableLine(digitableLine string) string {
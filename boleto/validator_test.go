@@ -1,6 +1,7 @@
 package boleto_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/boleto"
@@ -16,6 +17,7 @@ var tables = []struct {
 	{"00190000090114971860168524522114975860000102656", false},
 	{"00190000090114971860168524522114675860000102656", true},
 	{"0019000009 01149.718601 68524.522114 6 75860000102656", true},
+	{"0019000009_01149.718601_68524.522114_6_75860000102656", true},
 }
 
 func TestValidate(t *testing.T) {
@@ -25,3 +27,23 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"00190000090114971860168524522114675860000102656", nil},
+	{"000111", boleto.ErrInvalidLength},
+	{"0019000009011497186016852452211467586000010265a", boleto.ErrInvalidCharacters},
+	{"0019000009_01149.718601_68524.522114_6_75860000102656", boleto.ErrInvalidCharacters},
+	{"00190000020114971860168524522114675860000102656", boleto.ErrInvalidFieldCheckDigit},
+	{"00190000090114971860168524522114975860000102656", boleto.ErrInvalidCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := boleto.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
@@ -21,6 +21,14 @@ func IsValid(cep string) bool {
 	return len(cleaned) == cepSize && cleaned == cep
 }
 
+// Validator implements the brutils.Validator interface for CEPs using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
+}
+
 // Format formats a CEP string into the standard "XXXXX-XXX" format.
 // Returns an empty string if the input is not a valid 8-digit CEP.
 func Format(cep string) string {
@@ -29,6 +37,29 @@ func Format(cep string) string {
 		return ""
 	}
 
+	return format(cleaned)
+}
+
+// Formatter implements the brutils.Formatter interface for CEPs using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
+// FormatPartial formats the digits present in a CEP being typed, e.g.
+// "01001-0" for "010010". Input longer than 8 digits is truncated.
+func FormatPartial(cep string) string {
+	cleaned := helpers.OnlyNumbers(cep)
+	if len(cleaned) > cepSize {
+		cleaned = cleaned[:cepSize]
+	}
+
+	return format(cleaned)
+}
+
+func format(cleaned string) string {
 	buf := bytes.Buffer{}
 	for index, character := range cleaned {
 		if helpers.ContainsInt(hyphenIndexes, index) {
@@ -61,3 +61,23 @@ func TestGenerate(t *testing.T) {
 		t.Errorf("Expected generated CEP to be valid, got %v", generated)
 	}
 }
+
+var formatPartialTests = []struct {
+	input    string
+	expected string
+}{
+	{"", ""},
+	{"0100", "0100"},
+	{"01001", "01001"},
+	{"010010", "01001-0"},
+	{"01001-000", "01001-000"},
+	{"010010001", "01001-000"},
+}
+
+func TestFormatPartial(t *testing.T) {
+	for _, table := range formatPartialTests {
+		if res := cep.FormatPartial(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package cnh
+
+import (
+	"log/slog"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Digits of a CNH left visible by Mask
+var defaultVisibleRange = [2]int{3, 9}
+
+// Mask returns the CNH digits with all but the middle six hidden,
+// e.g. "***654321**". Returns an empty string if the input does not
+// contain exactly 11 digits.
+func Mask(cnh string) string {
+	return MaskRange(cnh, defaultVisibleRange[0], defaultVisibleRange[1])
+}
+
+// MaskRange returns the CNH digits with only the ones at indexes
+// [start, end) visible. Returns an empty string if the input does not
+// contain exactly 11 digits.
+func MaskRange(cnh string, start int, end int) string {
+	cnhNumbers := helpers.OnlyNumbers(cnh)
+	if len(cnhNumbers) != cnhSize {
+		return ""
+	}
+
+	return helpers.Mask(cnhNumbers, [2]int{start, end})
+}
+
+// Masked is a CNH that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
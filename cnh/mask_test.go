@@ -0,0 +1,42 @@
+package cnh_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/cnh"
+)
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"98765432100", "***654321**"},
+	{"987654321-00", "***654321**"},
+	{"9876543210", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := cnh.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+func TestMaskRange(t *testing.T) {
+	if res := cnh.MaskRange("98765432100", 9, 11); res != "*********00" {
+		t.Errorf("Expected %v, got %v", "*********00", res)
+	}
+}
+
+func TestMaskedLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("test", "value", cnh.Masked("98765432100"))
+
+	if !strings.Contains(buf.String(), `value=`+`***654321**`) {
+		t.Errorf("Expected masked value in log, got %v", buf.String())
+	}
+}
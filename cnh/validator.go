@@ -1,11 +1,24 @@
 package cnh
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/brazilian-utils/go/helpers"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength           = errors.New("cnh: invalid length")
+	ErrInvalidCharacters       = errors.New("cnh: invalid characters")
+	ErrBlacklisted             = errors.New("cnh: blacklisted repeated sequence")
+	ErrInvalidFirstCheckDigit  = errors.New("cnh: invalid first check digit")
+	ErrInvalidSecondCheckDigit = errors.New("cnh: invalid second check digit")
+)
+
+// Digits and the formatting symbols accepted in a CNH
+const allowedCharacters = "0123456789.- "
+
 // Every CNH has exactly 11 digits
 const cnhSize = 11
 
@@ -26,15 +39,30 @@ var blacklist = []string{
 
 // IsValid validates if a given CNH (Carteira Nacional de Habilitação) is valid.
 // It checks the format (11 digits) and verifies both check digits.
+// Non-digit characters are ignored; use Validate to reject them.
 func IsValid(cnh string) bool {
-	cnhNumbers := helpers.OnlyNumbers(cnh)
+	return validate(helpers.OnlyNumbers(cnh)) == nil
+}
 
+// Validate validates a given CNH and returns the reason why it is invalid,
+// or nil if it is valid.
+func Validate(cnh string) error {
+	if !helpers.ContainsOnly(cnh, allowedCharacters) {
+		return ErrInvalidCharacters
+	}
+
+	return validate(helpers.OnlyNumbers(cnh))
+}
+
+// validate checks the CNH once it has been reduced to its significant
+// characters, without looking at the symbols that were stripped from it.
+func validate(cnhNumbers string) error {
 	if len(cnhNumbers) != cnhSize {
-		return false
+		return ErrInvalidLength
 	}
 
 	if helpers.Contains(blacklist, cnhNumbers) {
-		return false
+		return ErrBlacklisted
 	}
 
 	digits := toDigits(cnhNumbers)
@@ -42,10 +70,22 @@ func IsValid(cnh string) bool {
 	secondVerifier := digits[10]
 
 	if !checkFirstVerifier(digits, firstVerifier) {
-		return false
+		return ErrInvalidFirstCheckDigit
+	}
+
+	if !checkSecondVerifier(digits, secondVerifier, firstVerifier) {
+		return ErrInvalidSecondCheckDigit
 	}
 
-	return checkSecondVerifier(digits, secondVerifier, firstVerifier)
+	return nil
+}
+
+// Validator implements the brutils.Validator interface for CNHs using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
 }
 
 // checkFirstVerifier validates the 10th digit (first check digit).
@@ -1,6 +1,7 @@
 package cnh_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/cnh"
@@ -13,6 +14,7 @@ var tables = []struct {
 	// Valid CNHs
 	{"98765432100", true},
 	{"987654321-00", true},
+	{"987654321/00", true},
 
 	// Blacklisted sequences
 	{"00000000000", false},
@@ -47,3 +49,24 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"98765432100", nil},
+	{"1234567890", cnh.ErrInvalidLength},
+	{"A2C45678901", cnh.ErrInvalidCharacters},
+	{"987654321/00", cnh.ErrInvalidCharacters},
+	{"11111111111", cnh.ErrBlacklisted},
+	{"98765432110", cnh.ErrInvalidFirstCheckDigit},
+	{"98765432101", cnh.ErrInvalidSecondCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := cnh.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package cnpj
+
+import (
+	"errors"
+	"regexp"
+)
+
+// Errors returned by ForBranch.
+var (
+	ErrInvalidRoot   = errors.New("cnpj: invalid root")
+	ErrInvalidBranch = errors.New("cnpj: invalid branch")
+)
+
+// Sizes of the CNPJ parts: root (company), branch (establishment)
+const (
+	rootSize   = 8
+	branchSize = 4
+)
+
+// Branch number of the company headquarters
+const headquartersBranch = "0001"
+
+var rootRegex = regexp.MustCompile(`^[0-9A-Z]{8}$`)
+var branchRegex = regexp.MustCompile(`^[0-9A-Z]{4}$`)
+
+// CNPJ is a validated CNPJ holding its 14 normalized characters, as returned
+// by Parse. The zero value represents an absent CNPJ. Accessors return their
+// zero value for a CNPJ converted from a string that is not 14 normalized
+// characters.
+type CNPJ string
+
+// Parse validates the given CNPJ and returns it normalized.
+// The returned error is the same one reported by Validate.
+func Parse(cnpj string) (CNPJ, error) {
+	if err := Validate(cnpj); err != nil {
+		return "", err
+	}
+
+	return CNPJ(normalize(cnpj)), nil
+}
+
+// ForBranch builds the valid CNPJ of another establishment of the company
+// identified by root (8 characters), computing its check digits.
+// Formatting symbols in root are ignored; branch must have 4 characters
+// and cannot be "0000".
+func ForBranch(root string, branch string) (CNPJ, error) {
+	root = normalize(root)
+	if !rootRegex.MatchString(root) {
+		return "", ErrInvalidRoot
+	}
+
+	branch = normalize(branch)
+	if !branchRegex.MatchString(branch) || branch == "0000" {
+		return "", ErrInvalidBranch
+	}
+
+	base := root + branch
+	return Parse(base + checksum(base))
+}
+
+// Root returns the 8 characters that identify the company.
+func (c CNPJ) Root() string {
+	if !c.normalized() {
+		return ""
+	}
+
+	return string(c[:rootSize])
+}
+
+// Branch returns the 4 characters that identify the establishment.
+func (c CNPJ) Branch() string {
+	if !c.normalized() {
+		return ""
+	}
+
+	return string(c[rootSize : rootSize+branchSize])
+}
+
+// IsHeadquarters reports whether the CNPJ belongs to the company
+// headquarters (branch "0001").
+func (c CNPJ) IsHeadquarters() bool {
+	return c.Branch() == headquartersBranch
+}
+
+// CheckDigits returns the two verification digits of the CNPJ.
+func (c CNPJ) CheckDigits() string {
+	if !c.normalized() {
+		return ""
+	}
+
+	return string(c[verifierIndexes[0]:])
+}
+
+// Format returns the CNPJ formatted as "XX.XXX.XXX/XXXX-XX".
+func (c CNPJ) Format() string {
+	return format(string(c))
+}
+
+// normalized reports whether the CNPJ holds 14 normalized characters, as
+// built by Parse
+func (c CNPJ) normalized() bool {
+	return cnpjRegex.MatchString(string(c))
+}
+
+// String implements fmt.Stringer and returns the formatted CNPJ.
+func (c CNPJ) String() string {
+	return c.Format()
+}
@@ -0,0 +1,96 @@
+package cnpj_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/cnpj"
+)
+
+var parseTests = []struct {
+	input          string
+	root           string
+	branch         string
+	isHeadquarters bool
+	checkDigits    string
+	formatted      string
+}{
+	{"60.391.947/0001-00", "60391947", "0001", true, "00", "60.391.947/0001-00"},
+	{"12abc34501de35", "12ABC345", "01DE", false, "35", "12.ABC.345/01DE-35"},
+}
+
+func TestParse(t *testing.T) {
+	for _, table := range parseTests {
+		parsed, err := cnpj.Parse(table.input)
+		if err != nil {
+			t.Fatalf("Failing for %v \t Unexpected error: %v", table.input, err)
+		}
+		if res := parsed.Root(); res != table.root {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.root, res)
+		}
+		if res := parsed.Branch(); res != table.branch {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.branch, res)
+		}
+		if res := parsed.IsHeadquarters(); res != table.isHeadquarters {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.isHeadquarters, res)
+		}
+		if res := parsed.CheckDigits(); res != table.checkDigits {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.checkDigits, res)
+		}
+		if res := parsed.String(); res != table.formatted {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.formatted, res)
+		}
+	}
+}
+
+func TestUnparsedCNPJ(t *testing.T) {
+	for _, input := range []cnpj.CNPJ{"", "x", "60.391.947/0001-00", "12abc34501de35"} {
+		if res := input.Root() + input.Branch() + input.CheckDigits(); res != "" {
+			t.Errorf("Failing for %q \t Expected: \"\" | Received: %v", input, res)
+		}
+		if input.IsHeadquarters() {
+			t.Errorf("Failing for %q \t Expected not to be the headquarters", input)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	if _, err := cnpj.Parse("13723705000188"); !errors.Is(err, cnpj.ErrInvalidSecondCheckDigit) {
+		t.Errorf("Expected ErrInvalidSecondCheckDigit, got %v", err)
+	}
+}
+
+var forBranchTests = []struct {
+	root     string
+	branch   string
+	expected cnpj.CNPJ
+	err      error
+}{
+	{"60.391.947", "0001", "60391947000100", nil},
+	{"11222333", "0001", "11222333000181", nil},
+	{"12ABC345", "01DE", "12ABC34501DE35", nil},
+	{"1122233", "0001", "", cnpj.ErrInvalidRoot},
+	{"11222333", "1", "", cnpj.ErrInvalidBranch},
+	{"11222333", "0000", "", cnpj.ErrInvalidBranch},
+}
+
+func TestForBranch(t *testing.T) {
+	for _, table := range forBranchTests {
+		res, err := cnpj.ForBranch(table.root, table.branch)
+		if res != table.expected || !errors.Is(err, table.err) {
+			t.Errorf("Failing for %v/%v \t Expected: %v (%v) | Received: %v (%v)", table.root, table.branch, table.expected, table.err, res, err)
+		}
+	}
+}
+
+func TestForBranchSiblings(t *testing.T) {
+	headquarters, _ := cnpj.Parse("60391947000100")
+
+	sibling, err := cnpj.ForBranch(headquarters.Root(), "0002")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sibling.Root() != headquarters.Root() || sibling.IsHeadquarters() || !cnpj.IsValid(string(sibling)) {
+		t.Errorf("Unexpected sibling CNPJ: %v", sibling)
+	}
+}
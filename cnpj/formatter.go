@@ -13,15 +13,37 @@ var slashIndexes = []int{8}
 var hyphenIndexes = []int{12}
 
 // Format returns the CNPJ with standard formatting: "XX.XXX.XXX/XXXX-XX".
-// Returns an empty string if the input does not contain exactly 14 digits.
+// Letters of an alphanumeric CNPJ are kept and uppercased.
+// Returns an empty string if the input does not contain exactly 14 characters
+// in the CNPJ layout (12 letters or digits followed by 2 digits).
 func Format(cnpj string) string {
-	cnpjNumbers := helpers.OnlyNumbers(cnpj)
+	normalized := normalize(cnpj)
 
-	if len(cnpjNumbers) != cnpjSize {
+	if !hasValidFormat(normalized) {
 		return ""
 	}
 
-	return format(cnpjNumbers)
+	return format(normalized)
+}
+
+// Formatter implements the brutils.Formatter interface for CNPJs using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
+// FormatPartial formats whatever characters of a CNPJ being typed are present,
+// e.g. "11.222.3" for "112223". Input longer than 14 characters is truncated.
+func FormatPartial(cnpj string) string {
+	normalized := normalize(cnpj)
+
+	if len(normalized) > cnpjSize {
+		normalized = normalized[:cnpjSize]
+	}
+
+	return format(normalized)
 }
 
 func format(normalized string) string {
@@ -16,6 +16,9 @@ var formatTests = []struct {
 	{"1372370500018", ""},
 	{"137237050001890", ""},
 	{"abcdefghijklmn", ""},
+	{"12ABC34501DE35", "12.ABC.345/01DE-35"},
+	{"12abc34501de35", "12.ABC.345/01DE-35"},
+	{"12.abc.345/01de-35", "12.ABC.345/01DE-35"},
 	{"", ""},
 }
 
@@ -26,3 +29,27 @@ func TestFormat(t *testing.T) {
 		}
 	}
 }
+
+var formatPartialTests = []struct {
+	input    string
+	expected string
+}{
+	{"", ""},
+	{"1", "1"},
+	{"11", "11"},
+	{"112", "11.2"},
+	{"112223", "11.222.3"},
+	{"112223330", "11.222.333/0"},
+	{"1122233300018", "11.222.333/0001-8"},
+	{"11222333000181", "11.222.333/0001-81"},
+	{"1122233300018199", "11.222.333/0001-81"},
+	{"12abc", "12.ABC"},
+}
+
+func TestFormatPartial(t *testing.T) {
+	for _, table := range formatPartialTests {
+		if res := cnpj.FormatPartial(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"strings"
 )
 
+// Characters allowed in the root of an alphanumeric CNPJ
+const alphanumericChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
 // Generate generates a random valid CNPJ string.
 // The branch parameter specifies the branch number (1-9999); it defaults
 // to 1 if 0 is provided.
@@ -20,7 +23,25 @@ func Generate(branch int) string {
 	return base + checksum(base)
 }
 
-// checksum computes the two verifying checksum digits for a 12-digit CNPJ base.
+// GenerateAlphanumeric generates a random valid alphanumeric CNPJ string,
+// whose 8-character root may contain uppercase letters and digits.
+// The branch parameter behaves as in Generate.
+func GenerateAlphanumeric(branch int) string {
+	branch = branch % 10000
+	if branch == 0 {
+		branch = 1
+	}
+
+	var root strings.Builder
+	for i := 0; i < 8; i++ {
+		root.WriteByte(alphanumericChars[rand.Intn(len(alphanumericChars))])
+	}
+
+	base := fmt.Sprintf("%s%04d", root.String(), branch)
+	return base + checksum(base)
+}
+
+// checksum computes the two verifying checksum digits for a 12-character CNPJ base.
 func checksum(base string) string {
 	first := hashDigit(base, 13)
 	second := hashDigit(base+strconv.Itoa(first), 14)
@@ -30,13 +51,11 @@ func checksum(base string) string {
 // hashDigit calculates the checksum digit at the given position.
 // Mirrors the Python _hashdigit function using the same weight sequence.
 func hashDigit(cnpj string, position int) int {
-	digits := strings.Split(cnpj[:position-1], "")
 	weights := generateWeights(position)
 
 	sum := 0
-	for i, d := range digits {
-		v, _ := strconv.Atoi(d)
-		sum += v * weights[i]
+	for i := 0; i < position-1; i++ {
+		sum += charValue(cnpj[i]) * weights[i]
 	}
 
 	mod := sum % 11
@@ -42,3 +42,18 @@ func TestGenerateWithZeroBranch(t *testing.T) {
 		t.Errorf("Expected branch 0001 for input 0, got %v", branch)
 	}
 }
+
+func TestGenerateAlphanumeric(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		generated := cnpj.GenerateAlphanumeric(1)
+		if len(generated) != 14 {
+			t.Fatalf("Expected generated CNPJ to have 14 characters, got %d", len(generated))
+		}
+		if !cnpj.IsValid(generated) {
+			t.Fatalf("Generated invalid alphanumeric CNPJ on iteration %d: %v", i, generated)
+		}
+		if branch := generated[8:12]; branch != "0001" {
+			t.Fatalf("Expected branch 0001, got %v", branch)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package cnpj
+
+import (
+	"log/slog"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Characters of a CNPJ left visible by Mask
+var defaultVisibleRange = [2]int{2, 8}
+
+// Mask returns the formatted CNPJ with all but the last six characters of
+// its root hidden, e.g. "**.222.333/****-**". Returns an empty string if
+// the input is not in the CNPJ layout.
+func Mask(cnpj string) string {
+	return MaskRange(cnpj, defaultVisibleRange[0], defaultVisibleRange[1])
+}
+
+// MaskRange returns the formatted CNPJ with only the characters at indexes
+// [start, end) visible. Returns an empty string if the input is not in the
+// CNPJ layout.
+func MaskRange(cnpj string, start int, end int) string {
+	normalized := normalize(cnpj)
+	if !hasValidFormat(normalized) {
+		return ""
+	}
+
+	return format(helpers.Mask(normalized, [2]int{start, end}))
+}
+
+// Masked is a CNPJ that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
+
+// LogValue implements slog.LogValuer, so a CNPJ is masked when logged.
+func (c CNPJ) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(c)))
+}
@@ -0,0 +1,42 @@
+package cnpj_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/cnpj"
+)
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"11222333000181", "**.222.333/****-**"},
+	{"12.abc.345/01de-35", "**.ABC.345/****-**"},
+	{"1122233300018", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := cnpj.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+func TestMaskRange(t *testing.T) {
+	if res := cnpj.MaskRange("11222333000181", 0, 12); res != "11.222.333/0001-**" {
+		t.Errorf("Expected %v, got %v", "11.222.333/0001-**", res)
+	}
+}
+
+func TestMaskedLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("test", "value", cnpj.Masked("11222333000181"))
+
+	if !strings.Contains(buf.String(), `value=`+`**.222.333/****-**`) {
+		t.Errorf("Expected masked value in log, got %v", buf.String())
+	}
+}
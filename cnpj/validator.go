@@ -1,12 +1,23 @@
 package cnpj
 
 import (
+	"errors"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/brazilian-utils/go/helpers"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength           = errors.New("cnpj: invalid length")
+	ErrInvalidCharacters       = errors.New("cnpj: invalid characters")
+	ErrBlacklisted             = errors.New("cnpj: blacklisted repeated sequence")
+	ErrInvalidFirstCheckDigit  = errors.New("cnpj: invalid first check digit")
+	ErrInvalidSecondCheckDigit = errors.New("cnpj: invalid second check digit")
+)
+
 var blacklist = []string{
 	"00000000000000",
 	"11111111111111",
@@ -23,28 +34,77 @@ var blacklist = []string{
 // Position of verification digits
 var verifierIndexes = []int{12, 13}
 
+// Error reported for each verification digit
+var verifierErrors = []error{ErrInvalidFirstCheckDigit, ErrInvalidSecondCheckDigit}
+
+// Letters, digits and the formatting symbols accepted in a CNPJ
+const allowedCharacters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz./- "
+
 // Every CNPJ has exactly 14 characters
 const cnpjSize = 14
 
-// IsValid validates if a given CNPJ is valid
+// The first 12 characters may be digits or uppercase letters (alphanumeric
+// CNPJ, valid from July 2026); the two verification digits are always numeric.
+var cnpjRegex = regexp.MustCompile(`^[0-9A-Z]{12}[0-9]{2}$`)
+
+// IsValid validates if a given CNPJ is valid.
+// Both the traditional numeric CNPJ and the alphanumeric CNPJ are accepted.
+// Symbols other than letters and digits are ignored; use Validate to reject them.
 func IsValid(cnpj string) bool {
-	digits := helpers.OnlyNumbers(cnpj)
+	return validate(normalize(cnpj)) == nil
+}
 
-	return hasValidLength(digits) && !isBlacklisted(cnpj) && isValidChecksum(digits)
+// Validate validates a given CNPJ and returns the reason why it is invalid,
+// or nil if it is valid. Formatting symbols (".", "/" and "-") are accepted.
+func Validate(cnpj string) error {
+	if !helpers.ContainsOnly(cnpj, allowedCharacters) {
+		return ErrInvalidCharacters
+	}
+
+	return validate(normalize(cnpj))
 }
 
-// Perform checksum validation
-func isValidChecksum(cnpj string) bool {
-	validity := true
+// validate checks the CNPJ once it has been reduced to its significant
+// characters, without looking at the symbols that were stripped from it.
+func validate(normalized string) error {
+	if len(normalized) != cnpjSize {
+		return ErrInvalidLength
+	}
+	if !hasValidFormat(normalized) {
+		return ErrInvalidCharacters
+	}
+	if isBlacklisted(normalized) {
+		return ErrBlacklisted
+	}
+
+	return validateChecksum(normalized)
+}
+
+// Validator implements the brutils.Validator interface for CNPJs using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
+}
 
-	for _, verifier := range verifierIndexes {
+// normalize removes the formatting symbols and uppercases the letters
+func normalize(cnpj string) string {
+	return strings.ToUpper(helpers.OnlyAlphanumerics(cnpj))
+}
+
+// Perform checksum validation
+func validateChecksum(cnpj string) error {
+	for i, verifier := range verifierIndexes {
 		mod := computeMod(strings.Split(cnpj[:verifier], ""), verifier)
 
 		valid, _ := strconv.Atoi(string(cnpj[verifier]))
-		validity = validity && (valid == mod)
+		if valid != mod {
+			return verifierErrors[i]
+		}
 	}
 
-	return validity
+	return nil
 }
 
 // Compute the mod for the current slice of strings
@@ -57,8 +117,7 @@ func computeMod(digits []string, verifier int) (res int) {
 
 	var mod int
 	for index, digitStr := range digits {
-		digit, _ := strconv.Atoi(digitStr)
-		mod += digit * weights[index]
+		mod += charValue(digitStr[0]) * weights[index]
 	}
 
 	if mod = mod % 11; mod >= 2 {
@@ -68,9 +127,15 @@ func computeMod(digits []string, verifier int) (res int) {
 	return
 }
 
-// Validates the string length
-func hasValidLength(cnpj string) bool {
-	return len(cnpj) == cnpjSize
+// charValue returns the value of a CNPJ character for the checksum:
+// its ASCII code minus 48, so digits keep their value and 'A' is 17.
+func charValue(character byte) int {
+	return int(character) - '0'
+}
+
+// Validates the string length and the allowed characters
+func hasValidFormat(cnpj string) bool {
+	return len(cnpj) == cnpjSize && cnpjRegex.MatchString(cnpj)
 }
 
 func isBlacklisted(cnpj string) bool {
@@ -1,6 +1,7 @@
 package cnpj_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/cnpj"
@@ -23,6 +24,16 @@ var tables = []struct {
 	{"99999999999999", false},
 	{"13723705000189", true},
 	{"60.391.947/0001-00", true},
+	{"00.000.000/0000-00", false},
+
+	// Alphanumeric CNPJ
+	{"12ABC34501DE35", true},
+	{"12.ABC.345/01DE-35", true},
+	{"12.abc.345/01de-35", true},
+	{"12_ABC_345_01DE_35", true},
+	{"12ABC34501DE36", false},
+	{"12ABC34501DE3A", false},
+	{"12ABC34501D", false},
 }
 
 func TestValidate(t *testing.T) {
@@ -32,3 +43,26 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"13723705000189", nil},
+	{"12.ABC.345/01DE-35", nil},
+	{"1372370500018", cnpj.ErrInvalidLength},
+	{"13723705000189?", cnpj.ErrInvalidCharacters},
+	{"12_ABC_345_01DE_35", cnpj.ErrInvalidCharacters},
+	{"12ABC34501DE3A", cnpj.ErrInvalidCharacters},
+	{"00.000.000/0000-00", cnpj.ErrBlacklisted},
+	{"13723705000179", cnpj.ErrInvalidFirstCheckDigit},
+	{"13723705000188", cnpj.ErrInvalidSecondCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := cnpj.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
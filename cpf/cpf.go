@@ -0,0 +1,150 @@
+package cpf
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// fiscalRegions maps the ninth digit of a CPF to the federative units of the
+// fiscal region that issued it.
+var fiscalRegions = [10][]string{
+	{"RS"},
+	{"DF", "GO", "MS", "MT", "TO"},
+	{"AC", "AM", "AP", "PA", "RO", "RR"},
+	{"CE", "MA", "PI"},
+	{"AL", "PB", "PE", "RN"},
+	{"BA", "SE"},
+	{"MG"},
+	{"ES", "RJ"},
+	{"SP"},
+	{"PR", "SC"},
+}
+
+// Position of the digit that identifies the fiscal region
+const fiscalRegionIndex = 8
+
+// CPF is a validated CPF holding its 11 normalized digits, as returned by
+// Parse. The zero value represents an absent CPF. Accessors return their zero
+// value for a CPF converted from a string that is not 11 digits.
+type CPF string
+
+// Parse validates the given CPF and returns it normalized.
+// The returned error is the same one reported by Validate.
+func Parse(cpf string) (CPF, error) {
+	if err := Validate(cpf); err != nil {
+		return "", err
+	}
+
+	return CPF(helpers.OnlyNumbers(cpf)), nil
+}
+
+// Digits returns the 11 digits of the CPF without formatting.
+func (c CPF) Digits() string {
+	return string(c)
+}
+
+// Format returns the CPF formatted as "XXX.XXX.XXX-XX".
+func (c CPF) Format() string {
+	return format(string(c))
+}
+
+// CheckDigits returns the two verification digits of the CPF.
+func (c CPF) CheckDigits() string {
+	if !c.normalized() {
+		return ""
+	}
+
+	return string(c[verifierIndexes[0]:])
+}
+
+// FiscalRegion returns the federative units (e.g. "SP") of the fiscal region
+// that issued the CPF, as given by its ninth digit.
+func (c CPF) FiscalRegion() []string {
+	if !c.normalized() {
+		return nil
+	}
+
+	region := fiscalRegions[c[fiscalRegionIndex]-'0']
+	return append([]string(nil), region...)
+}
+
+// normalized reports whether the CPF holds 11 digits, as built by Parse
+func (c CPF) normalized() bool {
+	return len(c) == 11 && helpers.ContainsOnly(string(c), "0123456789")
+}
+
+// String implements fmt.Stringer and returns the formatted CPF.
+func (c CPF) String() string {
+	return c.Format()
+}
+
+// MarshalText implements encoding.TextMarshaler using the unformatted digits.
+func (c CPF) MarshalText() ([]byte, error) {
+	return []byte(c), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Formatted and unformatted
+// CPFs are accepted; an empty text results in the zero value.
+func (c *CPF) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = ""
+		return nil
+	}
+
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler using the unformatted digits.
+func (c CPF) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null results in the
+// zero value.
+func (c *CPF) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = ""
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	return c.UnmarshalText([]byte(text))
+}
+
+// Scan implements sql.Scanner. NULL results in the zero value.
+func (c *CPF) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*c = ""
+		return nil
+	case string:
+		return c.UnmarshalText([]byte(value))
+	case []byte:
+		return c.UnmarshalText(value)
+	default:
+		return fmt.Errorf("cpf: cannot scan %T into CPF", src)
+	}
+}
+
+// Value implements driver.Valuer using the unformatted digits.
+// The zero value is stored as NULL.
+func (c CPF) Value() (driver.Value, error) {
+	if c == "" {
+		return nil, nil
+	}
+
+	return string(c), nil
+}
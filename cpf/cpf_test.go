@@ -0,0 +1,115 @@
+package cpf_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/brazilian-utils/go/cpf"
+)
+
+var parseTests = []struct {
+	input        string
+	digits       string
+	formatted    string
+	checkDigits  string
+	fiscalRegion []string
+}{
+	{"40364478829", "40364478829", "403.644.788-29", "29", []string{"SP"}},
+	{"123.456.780-62", "12345678062", "123.456.780-62", "62", []string{"RS"}},
+	{"123.456.787-39", "12345678739", "123.456.787-39", "39", []string{"ES", "RJ"}},
+}
+
+func TestParse(t *testing.T) {
+	for _, table := range parseTests {
+		parsed, err := cpf.Parse(table.input)
+		if err != nil {
+			t.Fatalf("Failing for %v \t Unexpected error: %v", table.input, err)
+		}
+		if res := parsed.Digits(); res != table.digits {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.digits, res)
+		}
+		if res := parsed.Format(); res != table.formatted {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.formatted, res)
+		}
+		if res := parsed.String(); res != table.formatted {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.formatted, res)
+		}
+		if res := parsed.CheckDigits(); res != table.checkDigits {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.checkDigits, res)
+		}
+		if res := parsed.FiscalRegion(); !reflect.DeepEqual(res, table.fiscalRegion) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.fiscalRegion, res)
+		}
+	}
+}
+
+func TestUnparsedCPF(t *testing.T) {
+	for _, input := range []cpf.CPF{"", "123", "abcdefghijk", "403.644.788-29"} {
+		if res := input.CheckDigits(); res != "" {
+			t.Errorf("Failing for %q \t Expected: \"\" | Received: %v", input, res)
+		}
+		if res := input.FiscalRegion(); res != nil {
+			t.Errorf("Failing for %q \t Expected: nil | Received: %v", input, res)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	if _, err := cpf.Parse("40364478828"); !errors.Is(err, cpf.ErrInvalidSecondCheckDigit) {
+		t.Errorf("Expected ErrInvalidSecondCheckDigit, got %v", err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var payload struct {
+		CPF cpf.CPF `json:"cpf"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"cpf":"403.644.788-29"}`), &payload); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if payload.CPF != "40364478829" {
+		t.Errorf("Expected 40364478829, got %v", payload.CPF.Digits())
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(encoded) != `{"cpf":"40364478829"}` {
+		t.Errorf("Unexpected JSON: %s", encoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"cpf":"40364478828"}`), &payload); !errors.Is(err, cpf.ErrInvalidSecondCheckDigit) {
+		t.Errorf("Expected ErrInvalidSecondCheckDigit, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"cpf":null}`), &payload); err != nil || payload.CPF != "" {
+		t.Errorf("Expected zero value for null, got %v (%v)", payload.CPF, err)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	var scanned cpf.CPF
+	if err := scanned.Scan([]byte("40364478829")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	value, err := scanned.Value()
+	if err != nil || value != "40364478829" {
+		t.Errorf("Expected 40364478829, got %v (%v)", value, err)
+	}
+
+	if err := scanned.Scan(nil); err != nil || scanned != "" {
+		t.Errorf("Expected zero value for NULL, got %v (%v)", scanned, err)
+	}
+	if value, _ := scanned.Value(); value != nil {
+		t.Errorf("Expected NULL for zero value, got %v", value)
+	}
+
+	if err := scanned.Scan(42); err == nil {
+		t.Error("Expected error scanning an int")
+	}
+}
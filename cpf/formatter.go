@@ -22,6 +22,21 @@ func Format(cpf string) string {
 	return format(cpfNumbers)
 }
 
+// Formatter implements the brutils.Formatter interface for CPFs using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
+// FormatPartial formats the digits present in a CPF being typed, e.g.
+// "943.895.7" for "9438957". It behaves exactly like Format, which already
+// masks incomplete input, and exists for symmetry with the other packages.
+func FormatPartial(cpf string) string {
+	return Format(cpf)
+}
+
 func format(nomalizedCpf string) string {
 	buf := bytes.Buffer{}
 	for index, character := range nomalizedCpf {
@@ -33,3 +33,21 @@ func TestFormat(t *testing.T) {
 		}
 	}
 }
+
+var formatPartialTests = []struct {
+	input    string
+	expected string
+}{
+	{"", ""},
+	{"9438", "943.8"},
+	{"943.895.7", "943.895.7"},
+	{"94389575104000", "943.895.751-04"},
+}
+
+func TestFormatPartial(t *testing.T) {
+	for _, table := range formatPartialTests {
+		if res := cpf.FormatPartial(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
@@ -1,18 +1,226 @@
 package cpf
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync"
+	"time"
+)
+
+// Errors returned when building a Generator or generating CPFs.
+var (
+	ErrInvalidFiscalRegion = errors.New("cpf: invalid fiscal region")
+	ErrUnknownUF           = errors.New("cpf: unknown federative unit")
+	ErrTooManyCPFs         = errors.New("cpf: not enough distinct CPFs to generate")
+	ErrInvalidCount        = errors.New("cpf: invalid number of CPFs")
 )
 
 // Generate generates a random valid CPF string.
 func Generate() string {
 	base := fmt.Sprintf("%09d", rand.Intn(999999998)+1)
 
+	return base + checksum(base)
+}
+
+// Generator generates valid CPFs according to its options.
+// It is safe for concurrent use.
+type Generator struct {
+	mu        sync.Mutex
+	random    *rand.Rand
+	region    int
+	formatted bool
+}
+
+// Option configures a Generator.
+type Option func(*Generator) error
+
+// WithSeed makes the Generator deterministic: generators built with the same
+// seed and options produce the same sequence of CPFs.
+func WithSeed(seed int64) Option {
+	return WithSource(rand.NewSource(seed))
+}
+
+// WithSource makes the Generator draw its random numbers from source.
+func WithSource(source rand.Source) Option {
+	return func(g *Generator) error {
+		g.random = rand.New(source)
+		return nil
+	}
+}
+
+// WithFiscalRegion pins the ninth digit of the generated CPFs to the given
+// fiscal region (0-9).
+func WithFiscalRegion(region int) Option {
+	return func(g *Generator) error {
+		if region < 0 || region > 9 {
+			return ErrInvalidFiscalRegion
+		}
+
+		g.region = region
+		return nil
+	}
+}
+
+// WithUF pins the ninth digit of the generated CPFs to the fiscal region of
+// the given federative unit (e.g. "SP").
+func WithUF(uf string) Option {
+	return func(g *Generator) error {
+		uf = strings.ToUpper(uf)
+		for region, ufs := range fiscalRegions {
+			for _, candidate := range ufs {
+				if candidate == uf {
+					g.region = region
+					return nil
+				}
+			}
+		}
+
+		return ErrUnknownUF
+	}
+}
+
+// WithFormatting makes the Generator return formatted CPFs ("XXX.XXX.XXX-XX").
+func WithFormatting() Option {
+	return func(g *Generator) error {
+		g.formatted = true
+		return nil
+	}
+}
+
+// NewGenerator builds a Generator with the given options. Without options it
+// produces unformatted CPFs from any fiscal region using a time-seeded source.
+func NewGenerator(options ...Option) (*Generator, error) {
+	g := &Generator{region: -1}
+
+	for _, option := range options {
+		if err := option(g); err != nil {
+			return nil, err
+		}
+	}
+
+	if g.random == nil {
+		g.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return g, nil
+}
+
+// Generate generates a random valid CPF.
+func (g *Generator) Generate() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.generate()
+}
+
+// GenerateN generates n distinct random valid CPFs. It draws exactly n
+// bases without replacement, so it takes the same time per CPF however close
+// n is to the number of CPFs the Generator can produce.
+// Returns ErrInvalidCount if n is negative, or ErrTooManyCPFs if n exceeds
+// the number of CPFs the Generator can produce.
+func (g *Generator) GenerateN(n int) ([]string, error) {
+	if n < 0 {
+		return nil, ErrInvalidCount
+	}
+
+	blocked := g.blacklistedBases()
+	available := g.bases() - len(blocked)
+	if n > available {
+		return nil, ErrTooManyCPFs
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Floyd's algorithm samples n distinct indexes of the allowed bases
+	chosen := make(map[int]bool, n)
+	indexes := make([]int, 0, n)
+	for j := available - n; j < available; j++ {
+		index := g.random.Intn(j + 1)
+		if chosen[index] {
+			index = j
+		}
+		chosen[index] = true
+		indexes = append(indexes, index)
+	}
+	g.random.Shuffle(len(indexes), func(i, j int) {
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+	})
+
+	generated := make([]string, n)
+	for position, index := range indexes {
+		// Skip the blacklisted bases, which are sorted
+		for _, base := range blocked {
+			if index >= base {
+				index++
+			}
+		}
+		generated[position] = g.build(index)
+		if g.formatted {
+			generated[position] = format(generated[position])
+		}
+	}
+
+	return generated, nil
+}
+
+func (g *Generator) generate() string {
+	for {
+		cpf := g.build(g.random.Intn(g.bases()))
+		if isBlacklisted(cpf) {
+			continue
+		}
+
+		if g.formatted {
+			return format(cpf)
+		}
+		return cpf
+	}
+}
+
+// bases returns how many 9-digit bases the Generator draws from: all of
+// them, or the 8 digits before a pinned fiscal region
+func (g *Generator) bases() int {
+	if g.region >= 0 {
+		return 100000000
+	}
+	return 1000000000
+}
+
+// blacklistedBases returns the indexes, in ascending order, of the bases of
+// blacklisted CPFs among the bases the Generator draws from
+func (g *Generator) blacklistedBases() []int {
+	if g.region >= 0 {
+		return []int{g.region * 11111111}
+	}
+
+	blocked := make([]int, 10)
+	for digit := range blocked {
+		blocked[digit] = digit * 111111111
+	}
+	return blocked
+}
+
+// build returns the unformatted CPF of the base at index among the bases the
+// Generator draws from
+func (g *Generator) build(index int) string {
+	var base string
+	if g.region >= 0 {
+		base = fmt.Sprintf("%08d%d", index, g.region)
+	} else {
+		base = fmt.Sprintf("%09d", index)
+	}
+
+	return base + checksum(base)
+}
+
+// checksum computes the two verification digits for a 9-digit CPF base.
+func checksum(base string) string {
 	first := computeMod(strings.Split(base, ""))
 	withFirst := base + fmt.Sprintf("%d", first)
 	second := computeMod(strings.Split(withFirst, ""))
 
-	return fmt.Sprintf("%s%d%d", base, first, second)
+	return fmt.Sprintf("%d%d", first, second)
 }
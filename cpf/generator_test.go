@@ -1,6 +1,7 @@
 package cpf_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/cpf"
@@ -24,3 +25,100 @@ func TestGenerateMultiple(t *testing.T) {
 		}
 	}
 }
+
+func TestGeneratorSeed(t *testing.T) {
+	first, _ := cpf.NewGenerator(cpf.WithSeed(42))
+	second, _ := cpf.NewGenerator(cpf.WithSeed(42))
+
+	for i := 0; i < 10; i++ {
+		a, b := first.Generate(), second.Generate()
+		if a != b {
+			t.Fatalf("Expected same CPF for same seed, got %v and %v", a, b)
+		}
+		if !cpf.IsValid(a) {
+			t.Fatalf("Generated invalid CPF: %v", a)
+		}
+	}
+}
+
+func TestGeneratorUF(t *testing.T) {
+	generator, err := cpf.NewGenerator(cpf.WithUF("rj"), cpf.WithFormatting())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		generated := generator.Generate()
+		if len(generated) != 14 {
+			t.Fatalf("Expected formatted CPF, got %v", generated)
+		}
+		parsed, err := cpf.Parse(generated)
+		if err != nil {
+			t.Fatalf("Generated invalid CPF %v: %v", generated, err)
+		}
+		if region := parsed.FiscalRegion(); region[len(region)-1] != "RJ" {
+			t.Fatalf("Expected fiscal region of RJ, got %v", region)
+		}
+	}
+}
+
+func TestGeneratorInvalidOptions(t *testing.T) {
+	if _, err := cpf.NewGenerator(cpf.WithUF("XX")); !errors.Is(err, cpf.ErrUnknownUF) {
+		t.Errorf("Expected ErrUnknownUF, got %v", err)
+	}
+	if _, err := cpf.NewGenerator(cpf.WithFiscalRegion(10)); !errors.Is(err, cpf.ErrInvalidFiscalRegion) {
+		t.Errorf("Expected ErrInvalidFiscalRegion, got %v", err)
+	}
+}
+
+func TestGenerateN(t *testing.T) {
+	generator, _ := cpf.NewGenerator(cpf.WithSeed(1), cpf.WithFiscalRegion(8))
+
+	generated, err := generator.GenerateN(1000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(generated) != 1000 {
+		t.Fatalf("Expected 1000 CPFs, got %d", len(generated))
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range generated {
+		if seen[c] {
+			t.Fatalf("Duplicate CPF generated: %v", c)
+		}
+		if !cpf.IsValid(c) || c[8] != '8' {
+			t.Fatalf("Generated unexpected CPF: %v", c)
+		}
+		seen[c] = true
+	}
+
+	if _, err := generator.GenerateN(100000000); !errors.Is(err, cpf.ErrTooManyCPFs) {
+		t.Errorf("Expected ErrTooManyCPFs, got %v", err)
+	}
+
+	if _, err := generator.GenerateN(-1); !errors.Is(err, cpf.ErrInvalidCount) {
+		t.Errorf("Expected ErrInvalidCount, got %v", err)
+	}
+
+	if generated, err := generator.GenerateN(0); err != nil || len(generated) != 0 {
+		t.Errorf("Expected no CPFs, got %v (%v)", generated, err)
+	}
+}
+
+func TestGenerateNFormatted(t *testing.T) {
+	generator, _ := cpf.NewGenerator(cpf.WithSeed(2), cpf.WithFiscalRegion(0), cpf.WithFormatting())
+
+	generated, err := generator.GenerateN(20000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	seen := make(map[string]bool, len(generated))
+	for _, c := range generated {
+		if seen[c] || !cpf.IsValid(c) || len(c) != 14 || c[10] != '0' {
+			t.Fatalf("Generated unexpected CPF: %v", c)
+		}
+		seen[c] = true
+	}
+}
@@ -0,0 +1,42 @@
+package cpf
+
+import (
+	"log/slog"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Digits of a CPF left visible by Mask, as done by gov.br
+var defaultVisibleRange = [2]int{3, 9}
+
+// Mask returns the formatted CPF with all but its middle six digits hidden,
+// e.g. "***.644.788-**". Returns an empty string if the input does not
+// contain exactly 11 digits.
+func Mask(cpf string) string {
+	return MaskRange(cpf, defaultVisibleRange[0], defaultVisibleRange[1])
+}
+
+// MaskRange returns the formatted CPF with only the digits at indexes
+// [start, end) visible. Returns an empty string if the input does not
+// contain exactly 11 digits.
+func MaskRange(cpf string, start int, end int) string {
+	cpfNumbers := helpers.OnlyNumbers(cpf)
+	if !hasValidLength(cpfNumbers) {
+		return ""
+	}
+
+	return format(helpers.Mask(cpfNumbers, [2]int{start, end}))
+}
+
+// Masked is a CPF that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
+
+// LogValue implements slog.LogValuer, so a CPF is masked when logged.
+func (c CPF) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(c)))
+}
@@ -0,0 +1,50 @@
+package cpf_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/cpf"
+)
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"40364478829", "***.644.788-**"},
+	{"403.644.788-29", "***.644.788-**"},
+	{"403644788", ""},
+	{"", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := cpf.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+func TestMaskRange(t *testing.T) {
+	if res := cpf.MaskRange("40364478829", 0, 3); res != "403.***.***-**" {
+		t.Errorf("Expected %v, got %v", "403.***.***-**", res)
+	}
+}
+
+func TestMaskedLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("test", "value", cpf.Masked("40364478829"))
+
+	if !strings.Contains(buf.String(), `value=`+`***.644.788-**`) {
+		t.Errorf("Expected masked value in log, got %v", buf.String())
+	}
+}
+
+func TestCPFLogValue(t *testing.T) {
+	parsed, _ := cpf.Parse("40364478829")
+	if res := parsed.LogValue().String(); res != "***.644.788-**" {
+		t.Errorf("Expected masked CPF, got %v", res)
+	}
+}
@@ -1,12 +1,22 @@
 package cpf
 
 import (
+	"errors"
 	"strconv"
 	"strings"
 
 	"github.com/brazilian-utils/go/helpers"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength           = errors.New("cpf: invalid length")
+	ErrInvalidCharacters       = errors.New("cpf: invalid characters")
+	ErrBlacklisted             = errors.New("cpf: blacklisted repeated sequence")
+	ErrInvalidFirstCheckDigit  = errors.New("cpf: invalid first check digit")
+	ErrInvalidSecondCheckDigit = errors.New("cpf: invalid second check digit")
+)
+
 // Valid CPFs but blacklisted they are reserved
 var blacklist = []string{
 	"00000000000",
@@ -24,28 +34,64 @@ var blacklist = []string{
 // Position of verification digits
 var verifierIndexes = []int{9, 10}
 
+// Error reported for each verification digit
+var verifierErrors = []error{ErrInvalidFirstCheckDigit, ErrInvalidSecondCheckDigit}
+
+// Digits and the formatting symbols accepted in a CPF
+const allowedCharacters = "0123456789.- "
+
 // Every CPF has exactly 11 characters
 const cpfSize = 11
 
-// IsValid validates if a given CPF is valid
+// IsValid validates if a given CPF is valid.
+// Non-digit characters are ignored; use Validate to reject them.
 func IsValid(cpf string) bool {
-	cpfNumbers := helpers.OnlyNumbers(cpf)
+	return validate(helpers.OnlyNumbers(cpf)) == nil
+}
+
+// Validate validates a given CPF and returns the reason why it is invalid,
+// or nil if it is valid. Formatting symbols ("." and "-") are accepted.
+func Validate(cpf string) error {
+	if !helpers.ContainsOnly(cpf, allowedCharacters) {
+		return ErrInvalidCharacters
+	}
 
-	return hasValidLength(cpfNumbers) && !isBlacklisted(cpf) && isValidChecksum(cpfNumbers)
+	return validate(helpers.OnlyNumbers(cpf))
 }
 
-// Perform checksum validation
-func isValidChecksum(cpf string) bool {
-	validity := true
+// validate checks the CPF once it has been reduced to its significant
+// characters, without looking at the symbols that were stripped from it.
+func validate(cpfNumbers string) error {
+	if !hasValidLength(cpfNumbers) {
+		return ErrInvalidLength
+	}
+	if isBlacklisted(cpfNumbers) {
+		return ErrBlacklisted
+	}
+
+	return validateChecksum(cpfNumbers)
+}
 
-	for _, verifier := range verifierIndexes {
+// Validator implements the brutils.Validator interface for CPFs using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
+}
+
+// Perform checksum validation
+func validateChecksum(cpf string) error {
+	for i, verifier := range verifierIndexes {
 		mod := computeMod(strings.Split(cpf[:verifier], ""))
 
 		valid, _ := strconv.Atoi(string(cpf[verifier]))
-		validity = validity && (valid == mod)
+		if valid != mod {
+			return verifierErrors[i]
+		}
 	}
 
-	return validity
+	return nil
 }
 
 // Compute the mod for the current slice of strings
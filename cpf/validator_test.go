@@ -1,6 +1,7 @@
 package cpf_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/cpf"
@@ -24,6 +25,7 @@ var tables = []struct {
 	{"403644788290", false},
 	{"40364478829", true},
 	{"962.718.458-60", true},
+	{"962/718/458_60", true},
 }
 
 func TestValidate(t *testing.T) {
@@ -33,3 +35,26 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"40364478829", nil},
+	{"403.644.788-29", nil},
+	{"4036447882", cpf.ErrInvalidLength},
+	{"", cpf.ErrInvalidLength},
+	{"403.644.788-2a", cpf.ErrInvalidCharacters},
+	{"962/718/458_60", cpf.ErrInvalidCharacters},
+	{"000.000.000-00", cpf.ErrBlacklisted},
+	{"40364478819", cpf.ErrInvalidFirstCheckDigit},
+	{"40364478828", cpf.ErrInvalidSecondCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := cpf.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
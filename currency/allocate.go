@@ -0,0 +1,88 @@
+package currency
+
+import (
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+// Errors returned by Allocate, Installments and MulRatio. They can be
+// compared with errors.Is.
+var (
+	ErrInvalidRatio        = errors.New("currency: invalid ratio")
+	ErrInvalidInstallments = errors.New("currency: number of installments must be positive")
+)
+
+// Allocate splits total in parts proportional to ratios, such as cost center
+// shares, so that the parts always add up to total. Each part is first
+// truncated to centavos, and the leftover centavos go one each to the parts
+// with the largest discarded fractions, ties going to the earlier parts.
+// Allocate(FromCentavos(10000), 1, 1, 1) is R$ 33,34, R$ 33,33 and R$ 33,33.
+// Returns ErrInvalidRatio if there are no ratios, a ratio is negative or they
+// add up to zero.
+func Allocate(total Amount, ratios ...int) ([]Amount, error) {
+	var sum uint64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidRatio
+		}
+		var carry uint64
+		sum, carry = bits.Add64(sum, uint64(ratio), 0)
+		if carry != 0 {
+			return nil, ErrInvalidRatio
+		}
+	}
+	if sum == 0 {
+		return nil, ErrInvalidRatio
+	}
+
+	abs := absUint64(int64(total))
+	parts := make([]uint64, len(ratios))
+	remainders := make([]uint64, len(ratios))
+	leftover := abs
+	for index, ratio := range ratios {
+		// abs*ratio/sum never exceeds abs, so the quotient fits in 64 bits
+		hi, lo := bits.Mul64(abs, uint64(ratio))
+		parts[index], remainders[index] = bits.Div64(hi, lo, sum)
+		leftover -= parts[index]
+	}
+
+	order := make([]int, len(ratios))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]] > remainders[order[j]]
+	})
+	for _, index := range order[:leftover] {
+		parts[index]++
+	}
+
+	amounts := make([]Amount, len(parts))
+	for index, part := range parts {
+		amounts[index] = Amount(part)
+		if total < 0 {
+			amounts[index] = -amounts[index]
+		}
+	}
+
+	return amounts, nil
+}
+
+// Installments splits total in n installments (parcelas) that differ by at
+// most one centavo and add up to total, the first ones taking the leftover
+// centavos: Installments(FromCentavos(10000), 3) is R$ 33,34, R$ 33,33 and
+// R$ 33,33.
+// Returns ErrInvalidInstallments if n is not positive.
+func Installments(total Amount, n int) ([]Amount, error) {
+	if n <= 0 {
+		return nil, ErrInvalidInstallments
+	}
+
+	ratios := make([]int, n)
+	for index := range ratios {
+		ratios[index] = 1
+	}
+
+	return Allocate(total, ratios...)
+}
@@ -0,0 +1,79 @@
+package currency_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/brazilian-utils/go/currency"
+)
+
+var allocateTests = []struct {
+	total    currency.Amount
+	ratios   []int
+	expected []currency.Amount
+}{
+	{10000, []int{1, 1, 1}, []currency.Amount{3334, 3333, 3333}},
+	{10000, []int{50, 30, 20}, []currency.Amount{5000, 3000, 2000}},
+	{5, []int{1, 1, 1, 1}, []currency.Amount{2, 1, 1, 1}},
+	{100, []int{1, 2}, []currency.Amount{33, 67}},
+	{101, []int{3, 0, 7}, []currency.Amount{30, 0, 71}},
+	{-10000, []int{1, 1, 1}, []currency.Amount{-3334, -3333, -3333}},
+	{0, []int{1, 1}, []currency.Amount{0, 0}},
+	{100, []int{1}, []currency.Amount{100}},
+}
+
+func TestAllocate(t *testing.T) {
+	for _, table := range allocateTests {
+		res, err := currency.Allocate(table.total, table.ratios...)
+		if err != nil || !slices.Equal(res, table.expected) {
+			t.Errorf("Failing for %v %v \t Expected: %v | Received: %v (%v)", table.total.Centavos(), table.ratios, table.expected, res, err)
+		}
+
+		var sum currency.Amount
+		for _, part := range res {
+			sum += part
+		}
+		if sum != table.total {
+			t.Errorf("Failing for %v %v \t Parts add up to %v", table.total.Centavos(), table.ratios, sum.Centavos())
+		}
+	}
+}
+
+var allocateErrorTests = [][]int{
+	nil,
+	{0, 0},
+	{1, -1},
+}
+
+func TestAllocateErrors(t *testing.T) {
+	for _, ratios := range allocateErrorTests {
+		if _, err := currency.Allocate(100, ratios...); !errors.Is(err, currency.ErrInvalidRatio) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", ratios, currency.ErrInvalidRatio, err)
+		}
+	}
+}
+
+var installmentsTests = []struct {
+	total    currency.Amount
+	n        int
+	expected []currency.Amount
+}{
+	{10000, 3, []currency.Amount{3334, 3333, 3333}},
+	{10000, 4, []currency.Amount{2500, 2500, 2500, 2500}},
+	{100, 6, []currency.Amount{17, 17, 17, 17, 16, 16}},
+	{-200, 3, []currency.Amount{-67, -67, -66}},
+}
+
+func TestInstallments(t *testing.T) {
+	for _, table := range installmentsTests {
+		res, err := currency.Installments(table.total, table.n)
+		if err != nil || !slices.Equal(res, table.expected) {
+			t.Errorf("Failing for %v / %v \t Expected: %v | Received: %v (%v)", table.total.Centavos(), table.n, table.expected, res, err)
+		}
+	}
+
+	if _, err := currency.Installments(100, 0); !errors.Is(err, currency.ErrInvalidInstallments) {
+		t.Errorf("Expected ErrInvalidInstallments, got %v", err)
+	}
+}
@@ -0,0 +1,295 @@
+package currency
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/brazilian-utils/go/numwords"
+)
+
+// Amount is a value in Brazilian Reais, stored as an integer number of
+// centavos so that it never carries floating-point errors. Its zero value is
+// R$ 0,00.
+type Amount int64
+
+// Largest amount ConvertRealToText and Text spell out, in reais
+const maxTextReais = 1_000_000_000_000_000
+
+// FromCentavos returns the Amount of a number of centavos.
+func FromCentavos(centavos int64) Amount {
+	return Amount(centavos)
+}
+
+// FromFloat returns the Amount of a value in reais, rounded to centavos
+// according to mode. The value is read as its shortest decimal
+// representation, so 0.29 is 29 centavos even though it is stored as
+// 0.28999999999999998 in a float64.
+// Returns ErrInvalidAmount for NaN, or ErrOutOfRange for infinities and
+// values beyond the range of Amount.
+func FromFloat(value float64, mode RoundingMode) (Amount, error) {
+	if math.IsNaN(value) {
+		return 0, ErrInvalidAmount
+	}
+	if math.IsInf(value, 0) || math.Abs(value) >= math.MaxInt64/100 {
+		return 0, ErrOutOfRange
+	}
+
+	reais, decimals, _ := strings.Cut(strconv.FormatFloat(math.Abs(value), 'f', -1, 64), ".")
+	centavos, err := roundDecimal(reais, decimals, 2, mode)
+	if err != nil {
+		return 0, err
+	}
+
+	if value < 0 {
+		centavos = -centavos
+	}
+
+	return Amount(centavos), nil
+}
+
+// Centavos returns the amount as an integer number of centavos.
+func (a Amount) Centavos() int64 {
+	return int64(a)
+}
+
+// Float64 returns the amount in reais as the nearest float64.
+func (a Amount) Float64() float64 {
+	return float64(a) / 100
+}
+
+// Add returns the sum a + b.
+// Returns ErrOutOfRange if the sum does not fit in an Amount.
+func (a Amount) Add(b Amount) (Amount, error) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return 0, ErrOutOfRange
+	}
+	return sum, nil
+}
+
+// Sub returns the difference a - b.
+// Returns ErrOutOfRange if the difference does not fit in an Amount.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	difference := a - b
+	if (b > 0 && difference > a) || (b < 0 && difference < a) {
+		return 0, ErrOutOfRange
+	}
+	return difference, nil
+}
+
+// Mul returns the amount multiplied by an integer quantity, as in the total
+// of several items with the same unit price.
+// Returns ErrOutOfRange if the product does not fit in an Amount.
+func (a Amount) Mul(quantity int64) (Amount, error) {
+	negative := (a < 0) != (quantity < 0)
+	hi, product := bits.Mul64(absUint64(int64(a)), absUint64(quantity))
+
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++
+	}
+	if hi != 0 || product > limit {
+		return 0, ErrOutOfRange
+	}
+
+	if negative {
+		return Amount(-product), nil
+	}
+	return Amount(product), nil
+}
+
+// Neg returns the amount with the opposite sign. The smallest Amount,
+// math.MinInt64 centavos, has no opposite and is returned unchanged.
+func (a Amount) Neg() Amount {
+	return -a
+}
+
+// Abs returns the absolute value of the amount. The smallest Amount,
+// math.MinInt64 centavos, has no absolute value and is returned unchanged.
+func (a Amount) Abs() Amount {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// String implements fmt.Stringer and returns the amount as
+// "R$ X.XXX,XX", as FormatCurrency does.
+func (a Amount) String() string {
+	formatted, _ := a.Format()
+	return formatted
+}
+
+// Text returns the amount written out in Portuguese, as ConvertRealToText
+// does, e.g. "Mil e duzentos reais e cinquenta centavos".
+// Returns empty string for amounts exceeding 1 quadrillion reais.
+func (a Amount) Text() string {
+	reais, centavos := a.split()
+	if reais > maxTextReais {
+		return ""
+	}
+
+	var parts []string
+
+	if reais > 0 {
+		reaisText, _ := numwords.Format(int64(reais), numwords.WithUnit("real", "reais"))
+		parts = append(parts, reaisText)
+	}
+
+	if centavos > 0 {
+		centavosText, _ := numwords.Format(int64(centavos), numwords.WithUnit("centavo", "centavos"))
+		if reais > 0 {
+			centavosText = "e " + centavosText
+		}
+		parts = append(parts, centavosText)
+	}
+
+	if reais == 0 && centavos == 0 {
+		parts = append(parts, "zero reais")
+	}
+
+	result := strings.Join(parts, " ")
+	if a < 0 {
+		result = "menos " + result
+	}
+
+	// Capitalize first letter
+	return strings.ToUpper(result[:1]) + result[1:]
+}
+
+// MarshalText implements encoding.TextMarshaler as a decimal number of reais
+// with a dot and 2 decimals, e.g. "-1234.50".
+func (a Amount) MarshalText() ([]byte, error) {
+	reais, centavos := a.split()
+
+	sign := ""
+	if a < 0 {
+		sign = "-"
+	}
+
+	return fmt.Appendf(nil, "%s%d.%02d", sign, reais, centavos), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts a decimal
+// number of reais with a dot, such as "1234.5" or "-10"; extra decimals must
+// be zeros, so no centavo is lost. An empty text results in the zero value.
+// Use Parse for amounts formatted in Brazilian Reais.
+func (a *Amount) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*a = 0
+		return nil
+	}
+
+	parsed, err := parseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+
+	*a = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler as a JSON number of reais with 2
+// decimals, e.g. 1234.50.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return a.MarshalText()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts a JSON number or a
+// string in the format of UnmarshalText, read without going through a
+// float64. A JSON null results in the zero value.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*a = 0
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return a.UnmarshalText([]byte(text))
+	}
+
+	return a.UnmarshalText(data)
+}
+
+// Scan implements sql.Scanner for DECIMAL and NUMERIC columns, which drivers
+// return as text, integer or floating-point columns holding reais. Floats
+// are rounded with RoundHalfUp. NULL results in the zero value.
+func (a *Amount) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case string:
+		return a.UnmarshalText([]byte(value))
+	case []byte:
+		return a.UnmarshalText(value)
+	case int64:
+		if value > math.MaxInt64/100 || value < math.MinInt64/100 {
+			return ErrOutOfRange
+		}
+		*a = Amount(value * 100)
+		return nil
+	case float64:
+		parsed, err := FromFloat(value, RoundHalfUp)
+		if err != nil {
+			return err
+		}
+		*a = parsed
+		return nil
+	default:
+		return fmt.Errorf("currency: cannot scan %T into Amount", src)
+	}
+}
+
+// Value implements driver.Valuer as the decimal text of MarshalText, which
+// databases store exactly in DECIMAL and NUMERIC columns.
+func (a Amount) Value() (driver.Value, error) {
+	text, err := a.MarshalText()
+	return string(text), err
+}
+
+// split returns the absolute reais and centavos of the amount
+func (a Amount) split() (reais, centavos uint64) {
+	abs := uint64(a)
+	if a < 0 {
+		abs = -abs
+	}
+	return abs / 100, abs % 100
+}
+
+// parseDecimal reads a decimal number of reais with an optional minus sign
+// and a dot before the decimals
+func parseDecimal(s string) (Amount, error) {
+	unsigned, negative := strings.CutPrefix(s, "-")
+	reais, decimals, hasPoint := strings.Cut(unsigned, ".")
+	if !isDigits(reais) || (hasPoint && !isDigits(decimals)) {
+		return 0, ErrInvalidAmount
+	}
+
+	if len(decimals) > 2 {
+		if strings.Trim(decimals[2:], "0") != "" {
+			return 0, ErrTooManyDecimals
+		}
+		decimals = decimals[:2]
+	}
+
+	centavos, err := roundDecimal(reais, decimals, 2, RoundTruncate)
+	if err != nil {
+		return 0, err
+	}
+
+	if negative {
+		centavos = -centavos
+	}
+
+	return Amount(centavos), nil
+}
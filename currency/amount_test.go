@@ -0,0 +1,196 @@
+package currency_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brazilian-utils/go/currency"
+)
+
+var fromFloatTests = []struct {
+	input    float64
+	mode     currency.RoundingMode
+	expected currency.Amount
+}{
+	{1234.56, currency.RoundHalfUp, 123456},
+	{0.29, currency.RoundHalfUp, 29},
+	{0.29, currency.RoundTruncate, 29},
+	{2.675, currency.RoundHalfUp, 268},
+	{-2.675, currency.RoundHalfUp, -268},
+	{2.674, currency.RoundHalfUp, 267},
+	{2.679, currency.RoundTruncate, 267},
+	{-2.679, currency.RoundTruncate, -267},
+	{0.005, currency.RoundHalfUp, 1},
+	{0.0000001, currency.RoundHalfUp, 0},
+	{1e15, currency.RoundHalfUp, 100_000_000_000_000_000},
+}
+
+func TestFromFloat(t *testing.T) {
+	for _, table := range fromFloatTests {
+		res, err := currency.FromFloat(table.input, table.mode)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.expected, res, err)
+		}
+	}
+}
+
+var fromFloatErrorTests = []struct {
+	input    float64
+	expected error
+}{
+	{math.NaN(), currency.ErrInvalidAmount},
+	{math.Inf(1), currency.ErrOutOfRange},
+	{math.Inf(-1), currency.ErrOutOfRange},
+	{1e17, currency.ErrOutOfRange},
+}
+
+func TestFromFloatErrors(t *testing.T) {
+	for _, table := range fromFloatErrorTests {
+		if _, err := currency.FromFloat(table.input, currency.RoundHalfUp); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a, _ := currency.FromFloat(0.1, currency.RoundHalfUp)
+	b, _ := currency.FromFloat(0.2, currency.RoundHalfUp)
+	if sum, err := a.Add(b); err != nil || sum != 30 {
+		t.Errorf("Expected 30 centavos, got %v (%v)", sum.Centavos(), err)
+	}
+
+	price := currency.FromCentavos(1999)
+	subtotal, err := price.Mul(3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if total, err := subtotal.Sub(currency.FromCentavos(7)); err != nil || total != 5990 {
+		t.Errorf("Expected 5990 centavos, got %v (%v)", total.Centavos(), err)
+	}
+	if res, err := price.Mul(-2); err != nil || res != -3998 {
+		t.Errorf("Expected -3998 centavos, got %v (%v)", res.Centavos(), err)
+	}
+	if res := price.Neg(); res != -1999 || res.Abs() != 1999 {
+		t.Errorf("Expected -1999 and 1999, got %v and %v", res, res.Abs())
+	}
+	if res := price.Float64(); res != 19.99 {
+		t.Errorf("Expected 19.99, got %v", res)
+	}
+}
+
+var overflowTests = []struct {
+	name      string
+	operation func() (currency.Amount, error)
+	expected  currency.Amount
+	err       error
+}{
+	{"max + 1", func() (currency.Amount, error) { return currency.Amount(math.MaxInt64).Add(1) }, 0, currency.ErrOutOfRange},
+	{"min + -1", func() (currency.Amount, error) { return currency.Amount(math.MinInt64).Add(-1) }, 0, currency.ErrOutOfRange},
+	{"max + -1", func() (currency.Amount, error) { return currency.Amount(math.MaxInt64).Add(-1) }, math.MaxInt64 - 1, nil},
+	{"min - 1", func() (currency.Amount, error) { return currency.Amount(math.MinInt64).Sub(1) }, 0, currency.ErrOutOfRange},
+	{"0 - min", func() (currency.Amount, error) { return currency.Amount(0).Sub(math.MinInt64) }, 0, currency.ErrOutOfRange},
+	{"-1 - max", func() (currency.Amount, error) { return currency.Amount(-1).Sub(math.MaxInt64) }, math.MinInt64, nil},
+	{"max * 2", func() (currency.Amount, error) { return currency.Amount(math.MaxInt64).Mul(2) }, 0, currency.ErrOutOfRange},
+	{"min * -1", func() (currency.Amount, error) { return currency.Amount(math.MinInt64).Mul(-1) }, 0, currency.ErrOutOfRange},
+	{"min * 1", func() (currency.Amount, error) { return currency.Amount(math.MinInt64).Mul(1) }, math.MinInt64, nil},
+	{"2^62 * -2", func() (currency.Amount, error) { return currency.Amount(1 << 62).Mul(-2) }, math.MinInt64, nil},
+	{"2^62 * 2", func() (currency.Amount, error) { return currency.Amount(1 << 62).Mul(2) }, 0, currency.ErrOutOfRange},
+}
+
+func TestArithmeticOverflow(t *testing.T) {
+	for _, table := range overflowTests {
+		res, err := table.operation()
+		if res != table.expected || !errors.Is(err, table.err) {
+			t.Errorf("Failing for %v \t Expected: %v (%v) | Received: %v (%v)", table.name, table.expected.Centavos(), table.err, res.Centavos(), err)
+		}
+	}
+}
+
+var amountStringTests = []struct {
+	input    currency.Amount
+	expected string
+	text     string
+}{
+	{123456, "R$ 1.234,56", "Mil, duzentos e trinta e quatro reais e cinquenta e seis centavos"},
+	{0, "R$ 0,00", "Zero reais"},
+	{-1, "R$ -0,01", "Menos um centavo"},
+	{100000000, "R$ 1.000.000,00", "Um milhão de reais"},
+	{math.MinInt64, "R$ -92.233.720.368.547.758,08", ""},
+}
+
+func TestAmountString(t *testing.T) {
+	for _, table := range amountStringTests {
+		if res := table.input.String(); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", int64(table.input), table.expected, res)
+		}
+		if res := table.input.Text(); res != table.text {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", int64(table.input), table.text, res)
+		}
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	var payload struct {
+		Total currency.Amount `json:"total"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":1234.5}`), &payload); err != nil || payload.Total != 123450 {
+		t.Errorf("Expected 123450, got %v (%v)", payload.Total.Centavos(), err)
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(encoded) != `{"total":1234.50}` {
+		t.Errorf("Unexpected JSON: %s", encoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":"-0.10"}`), &payload); err != nil || payload.Total != -10 {
+		t.Errorf("Expected -10, got %v (%v)", payload.Total.Centavos(), err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":0.125}`), &payload); !errors.Is(err, currency.ErrTooManyDecimals) {
+		t.Errorf("Expected ErrTooManyDecimals, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":1e3}`), &payload); !errors.Is(err, currency.ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":null}`), &payload); err != nil || payload.Total != 0 {
+		t.Errorf("Expected zero value for null, got %v (%v)", payload.Total, err)
+	}
+}
+
+var scanTests = []struct {
+	input    any
+	expected currency.Amount
+}{
+	{[]byte("1234.5600"), 123456},
+	{"-10", -1000},
+	{int64(42), 4200},
+	{19.99, 1999},
+	{nil, 0},
+}
+
+func TestAmountSQL(t *testing.T) {
+	for _, table := range scanTests {
+		var scanned currency.Amount = 1
+		if err := scanned.Scan(table.input); err != nil || scanned != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.expected, scanned, err)
+		}
+	}
+
+	value, err := currency.FromCentavos(-5).Value()
+	if err != nil || value != "-0.05" {
+		t.Errorf("Expected -0.05, got %v (%v)", value, err)
+	}
+
+	var scanned currency.Amount
+	if err := scanned.Scan(true); err == nil {
+		t.Errorf("Expected an error scanning a bool")
+	}
+}
@@ -19,6 +19,9 @@ var formatTests = []struct {
 	{1.00, "R$ 1,00"},
 	{123456789.01, "R$ 123.456.789,01"},
 	{-0.01, "R$ -0,01"},
+	{2.675, "R$ 2,67"},
+	{-0.001, "R$ -0,00"},
+	{1e20, "R$ 100.000.000.000.000.000.000,00"},
 }
 
 func TestFormatCurrency(t *testing.T) {
@@ -66,6 +69,8 @@ var convertTests = []struct {
 	{1000000000000, "Um trilhão de reais"},
 	{-5.25, "Menos cinco reais e vinte e cinco centavos"},
 	{1500000, "Um milhão e quinhentos mil reais"},
+	{0.29, "Vinte e nove centavos"},
+	{1.999, "Um real e noventa e nove centavos"},
 }
 
 func TestConvertRealToText(t *testing.T) {
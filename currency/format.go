@@ -0,0 +1,298 @@
+package currency
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Errors returned when building the formatting options.
+var (
+	ErrInvalidDecimals      = errors.New("currency: decimals must be 0, 2 or 4")
+	ErrInvalidNegativeStyle = errors.New("currency: invalid negative style")
+)
+
+// NegativeStyle sets where the sign of negative amounts goes.
+type NegativeStyle int
+
+// Supported negative styles.
+const (
+	// NegativeAfterSymbol writes the minus sign after the symbol: "R$ -10,00".
+	NegativeAfterSymbol NegativeStyle = iota
+	// NegativeBeforeSymbol writes the minus sign before the symbol, as
+	// Intl.NumberFormat does: "-R$ 10,00".
+	NegativeBeforeSymbol
+	// NegativeParentheses writes negative amounts in parentheses, as in
+	// accounting: "(R$ 10,00)".
+	NegativeParentheses
+)
+
+const (
+	nonBreakingSpace = "\u00a0"
+	defaultDecimals  = 2
+)
+
+// Compact notation scales, from the largest, as in the CLDR short format
+var compactScales = []struct {
+	suffix string
+	reais  uint64
+}{
+	{"tri", 1_000_000_000_000},
+	{"bi", 1_000_000_000},
+	{"mi", 1_000_000},
+	{"mil", 1_000},
+}
+
+// FormatOption configures the formatting of amounts.
+type FormatOption func(*formatOptions) error
+
+type formatOptions struct {
+	symbol   bool
+	space    string
+	negative NegativeStyle
+	decimals int
+	rounding RoundingMode
+	decimal  bool // round floats as decimals, set by WithRounding
+	compact  bool
+}
+
+// WithoutSymbol omits the "R$" symbol: "1.234,56".
+func WithoutSymbol() FormatOption {
+	return func(o *formatOptions) error {
+		o.symbol = false
+		return nil
+	}
+}
+
+// WithNonBreakingSpace separates the symbol, and the suffix of the compact
+// notation, with a non-breaking space (U+00A0), matching Intl.NumberFormat
+// and CLDR.
+func WithNonBreakingSpace() FormatOption {
+	return func(o *formatOptions) error {
+		o.space = nonBreakingSpace
+		return nil
+	}
+}
+
+// WithNegativeStyle sets where the sign of negative amounts goes. The default
+// is NegativeAfterSymbol.
+func WithNegativeStyle(style NegativeStyle) FormatOption {
+	return func(o *formatOptions) error {
+		if style < NegativeAfterSymbol || style > NegativeParentheses {
+			return ErrInvalidNegativeStyle
+		}
+
+		o.negative = style
+		return nil
+	}
+}
+
+// WithDecimals sets a fixed number of decimal places: 0 for whole reais, 2
+// (the default) or 4 for unit prices. Extra decimals are rounded with the
+// mode of WithRounding.
+func WithDecimals(decimals int) FormatOption {
+	return func(o *formatOptions) error {
+		if decimals != 0 && decimals != 2 && decimals != 4 {
+			return ErrInvalidDecimals
+		}
+
+		o.decimals = decimals
+		return nil
+	}
+}
+
+// WithRounding sets how extra decimals are rounded. The default is
+// RoundHalfUp.
+// By default FormatCurrency rounds the exact binary value of the float64, as
+// fmt's "%.2f" does, so 2.675 is "R$ 2,67". With WithRounding it rounds the
+// shortest decimal representation of the float64 instead: 2.675 is
+// "R$ 2,68" with RoundHalfUp.
+func WithRounding(mode RoundingMode) FormatOption {
+	return func(o *formatOptions) error {
+		o.rounding = mode
+		o.decimal = true
+		return nil
+	}
+}
+
+// WithCompact uses the compact notation from a thousand reais on, with up to
+// one decimal: "R$ 1,2 mil", "R$ 1,2 mi", "R$ 3,4 bi" and "R$ 5 tri".
+// Smaller amounts and the decimals of WithDecimals are not affected.
+func WithCompact() FormatOption {
+	return func(o *formatOptions) error {
+		o.compact = true
+		return nil
+	}
+}
+
+// Format returns the amount formatted in Brazilian Reais, by default as
+// "R$ X.XXX,XX" with the minus sign after the symbol.
+// Returns the error of an invalid option, or ErrOutOfRange if the amount
+// does not fit with 4 decimals.
+func (a Amount) Format(opts ...FormatOption) (string, error) {
+	o, err := newFormatOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return o.format(a < 0, absUint64(int64(a)), 2)
+}
+
+func newFormatOptions(opts []FormatOption) (*formatOptions, error) {
+	o := &formatOptions{
+		symbol:   true,
+		space:    " ",
+		decimals: defaultDecimals,
+		rounding: RoundHalfUp,
+	}
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// formatFloat formats a value in reais read as its shortest decimal
+// representation, so that 4 decimals are not limited to centavos
+func (o *formatOptions) formatFloat(value float64) (string, error) {
+	if math.IsNaN(value) {
+		return "", ErrInvalidAmount
+	}
+	if math.IsInf(value, 0) {
+		return "", ErrOutOfRange
+	}
+
+	if !o.decimal && !o.compact {
+		return o.formatBinary(value), nil
+	}
+
+	places := max(o.decimals, 2)
+	integer, decimals, _ := strings.Cut(strconv.FormatFloat(math.Abs(value), 'f', -1, 64), ".")
+	units, err := roundDecimal(integer, decimals, places, o.rounding)
+	if err != nil {
+		return "", err
+	}
+
+	return o.format(value < 0, uint64(units), places)
+}
+
+// formatBinary formats a value in reais rounded from its exact binary value,
+// as fmt's "%.2f" does. Negative values keep their sign even when they round
+// to zero, as in "R$ -0,00".
+func (o *formatOptions) formatBinary(value float64) string {
+	integer, decimals, _ := strings.Cut(strconv.FormatFloat(math.Abs(value), 'f', o.decimals, 64), ".")
+
+	number := groupThousands(integer)
+	if decimals != "" {
+		number += "," + decimals
+	}
+
+	return o.withSymbol(math.Signbit(value), number)
+}
+
+// format formats an absolute value given in units of its places-th decimal
+// place. Values that round to zero have no sign.
+func (o *formatOptions) format(negative bool, units uint64, places int) (string, error) {
+	var number string
+	if compact, ok := o.compactNumber(units, places); ok {
+		number = compact
+	} else {
+		units, err := rescale(units, places, o.decimals, o.rounding)
+		if err != nil {
+			return "", err
+		}
+		number = formatUnits(units, o.decimals)
+		negative = negative && units > 0
+	}
+
+	return o.withSymbol(negative, number), nil
+}
+
+// withSymbol adds the symbol and the sign to a formatted number
+func (o *formatOptions) withSymbol(negative bool, number string) string {
+	symbol := ""
+	if o.symbol {
+		symbol = "R$" + o.space
+	}
+
+	switch {
+	case !negative:
+		return symbol + number
+	case o.negative == NegativeParentheses:
+		return "(" + symbol + number + ")"
+	case o.negative == NegativeBeforeSymbol:
+		return "-" + symbol + number
+	default:
+		return symbol + "-" + number
+	}
+}
+
+// compactNumber returns the value in the largest compact scale it reaches,
+// rounded to tenths, or false if it is below a thousand reais or the compact
+// notation is off
+func (o *formatOptions) compactNumber(units uint64, places int) (string, bool) {
+	if !o.compact {
+		return "", false
+	}
+
+	reais, _ := rescale(units, places, 0, RoundTruncate)
+	for index, scale := range compactScales {
+		if reais < scale.reais {
+			continue
+		}
+
+		tenths, _ := rescale(units, places+scaleDigits(scale.reais)-1, 0, o.rounding)
+		if tenths >= 10_000 && index > 0 {
+			// Rounded up to a thousand of this scale, e.g. 999.96 mil is 1 mi
+			scale = compactScales[index-1]
+			tenths, _ = rescale(units, places+scaleDigits(scale.reais)-1, 0, o.rounding)
+		}
+
+		number := groupThousands(strconv.FormatUint(tenths/10, 10))
+		if tenths%10 != 0 {
+			number += "," + strconv.FormatUint(tenths%10, 10)
+		}
+		return number + o.space + scale.suffix, true
+	}
+
+	return "", false
+}
+
+// scaleDigits returns the number of zeros of a power of ten
+func scaleDigits(scale uint64) int {
+	digits := 0
+	for ; scale > 1; scale /= 10 {
+		digits++
+	}
+	return digits
+}
+
+// formatUnits writes a number of units of the places-th decimal place with
+// dots between the thousands and a comma before the decimals
+func formatUnits(units uint64, places int) string {
+	digits := strconv.FormatUint(units, 10)
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+
+	integer, decimals := digits[:len(digits)-places], digits[len(digits)-places:]
+	if places == 0 {
+		return groupThousands(integer)
+	}
+	return groupThousands(integer) + "," + decimals
+}
+
+// groupThousands inserts dots between groups of 3 digits
+func groupThousands(digits string) string {
+	var buf strings.Builder
+	for i, c := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			buf.WriteByte('.')
+		}
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
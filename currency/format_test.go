@@ -0,0 +1,94 @@
+package currency_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/currency"
+)
+
+var formatOptionsTests = []struct {
+	input    currency.Amount
+	options  []currency.FormatOption
+	expected string
+}{
+	{123456, nil, "R$ 1.234,56"},
+	{123456, []currency.FormatOption{currency.WithoutSymbol()}, "1.234,56"},
+	{-123456, []currency.FormatOption{currency.WithoutSymbol()}, "-1.234,56"},
+	{123456, []currency.FormatOption{currency.WithNonBreakingSpace()}, "R$\u00a01.234,56"},
+	{-1000, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeBeforeSymbol)}, "-R$ 10,00"},
+	{-1000, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeBeforeSymbol), currency.WithNonBreakingSpace()}, "-R$\u00a010,00"},
+	{-1000, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeParentheses)}, "(R$ 10,00)"},
+	{-1000, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeParentheses), currency.WithoutSymbol()}, "(10,00)"},
+	{1000, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeParentheses)}, "R$ 10,00"},
+	{123456, []currency.FormatOption{currency.WithDecimals(0)}, "R$ 1.235"},
+	{123450, []currency.FormatOption{currency.WithDecimals(0)}, "R$ 1.235"},
+	{123450, []currency.FormatOption{currency.WithDecimals(0), currency.WithRounding(currency.RoundHalfEven)}, "R$ 1.234"},
+	{123456, []currency.FormatOption{currency.WithDecimals(0), currency.WithRounding(currency.RoundTruncate)}, "R$ 1.234"},
+	{-40, []currency.FormatOption{currency.WithDecimals(0)}, "R$ 0"},
+	{123456, []currency.FormatOption{currency.WithDecimals(4)}, "R$ 1.234,5600"},
+	{5, []currency.FormatOption{currency.WithDecimals(4)}, "R$ 0,0500"},
+	{99999, []currency.FormatOption{currency.WithCompact()}, "R$ 999,99"},
+	{100000, []currency.FormatOption{currency.WithCompact()}, "R$ 1 mil"},
+	{1234567, []currency.FormatOption{currency.WithCompact()}, "R$ 12,3 mil"},
+	{120000000, []currency.FormatOption{currency.WithCompact()}, "R$ 1,2 mi"},
+	{340000000000, []currency.FormatOption{currency.WithCompact()}, "R$ 3,4 bi"},
+	{99996000000, []currency.FormatOption{currency.WithCompact()}, "R$ 1 bi"},
+	{99_999_999_99, []currency.FormatOption{currency.WithCompact()}, "R$ 100 mi"},
+	{500000000000000, []currency.FormatOption{currency.WithCompact()}, "R$ 5 tri"},
+	{-125000000, []currency.FormatOption{currency.WithCompact(), currency.WithNonBreakingSpace(), currency.WithNegativeStyle(currency.NegativeBeforeSymbol)}, "-R$\u00a01,3\u00a0mi"},
+	{125000000, []currency.FormatOption{currency.WithCompact(), currency.WithRounding(currency.RoundHalfEven)}, "R$ 1,2 mi"},
+}
+
+func TestAmountFormat(t *testing.T) {
+	for _, table := range formatOptionsTests {
+		res, err := table.input.Format(table.options...)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %q | Received: %q (%v)", table.input.Centavos(), table.expected, res, err)
+		}
+	}
+}
+
+var formatOptionErrorTests = []struct {
+	option   currency.FormatOption
+	expected error
+}{
+	{currency.WithDecimals(3), currency.ErrInvalidDecimals},
+	{currency.WithNegativeStyle(currency.NegativeStyle(7)), currency.ErrInvalidNegativeStyle},
+}
+
+func TestAmountFormatErrors(t *testing.T) {
+	for _, table := range formatOptionErrorTests {
+		if _, err := currency.FromCentavos(100).Format(table.option); !errors.Is(err, table.expected) {
+			t.Errorf("Expected: %v | Received: %v", table.expected, err)
+		}
+		if res := currency.FormatCurrency(1, table.option); res != "" {
+			t.Errorf("Expected empty string for an invalid option, got %v", res)
+		}
+	}
+}
+
+var formatCurrencyOptionsTests = []struct {
+	input    float64
+	options  []currency.FormatOption
+	expected string
+}{
+	{1.2345, []currency.FormatOption{currency.WithDecimals(4)}, "R$ 1,2345"},
+	{0.00015, []currency.FormatOption{currency.WithDecimals(4)}, "R$ 0,0001"},
+	{0.00015, []currency.FormatOption{currency.WithDecimals(4), currency.WithRounding(currency.RoundHalfUp)}, "R$ 0,0002"},
+	{-1234.5, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeParentheses), currency.WithDecimals(0)}, "(R$ 1.234)"},
+	{-1234.5, []currency.FormatOption{currency.WithNegativeStyle(currency.NegativeParentheses), currency.WithDecimals(0), currency.WithRounding(currency.RoundHalfUp)}, "(R$ 1.235)"},
+	{2.675, []currency.FormatOption{currency.WithRounding(currency.RoundHalfUp)}, "R$ 2,68"},
+	{2.675, []currency.FormatOption{currency.WithRounding(currency.RoundTruncate)}, "R$ 2,67"},
+	{-0.001, []currency.FormatOption{currency.WithRounding(currency.RoundHalfUp)}, "R$ 0,00"},
+	{-1234.56, []currency.FormatOption{currency.WithoutSymbol(), currency.WithNegativeStyle(currency.NegativeBeforeSymbol)}, "-1.234,56"},
+	{3.4e9, []currency.FormatOption{currency.WithCompact()}, "R$ 3,4 bi"},
+}
+
+func TestFormatCurrencyOptions(t *testing.T) {
+	for _, table := range formatCurrencyOptionsTests {
+		if res := currency.FormatCurrency(table.input, table.options...); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %q | Received: %q", table.input, table.expected, res)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package currency
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/brazilian-utils/go/helpers"
+	"github.com/brazilian-utils/go/numwords"
+)
+
+// Errors returned by Parse. They can be compared with errors.Is.
+var (
+	ErrInvalidAmount   = errors.New("currency: invalid amount")
+	ErrAmbiguousAmount = errors.New("currency: ambiguous thousands or decimal separator")
+	ErrInvalidGrouping = errors.New("currency: thousands separators must split the reais in groups of 3 digits")
+	ErrTooManyDecimals = errors.New("currency: more than 2 decimal places")
+	ErrOutOfRange      = errors.New("currency: amount out of range")
+)
+
+const symbol = "R$"
+
+// Parse reads an amount formatted in Brazilian Reais, such as "R$ 1.234,56",
+// "1234,56", "-R$ 10,00", "R$ -10,00" or the accounting notation
+// "(R$ 10,00)". The symbol is optional, dots group the reais in thousands and
+// a comma separates up to 2 decimals. Non-breaking spaces, as produced by
+// Intl.NumberFormat, and the Unicode minus sign are accepted.
+// Returns ErrAmbiguousAmount for input such as "1.234" or "1,234", which
+// other locales read differently, ErrInvalidGrouping, ErrTooManyDecimals,
+// ErrOutOfRange, or ErrInvalidAmount for any other malformed input.
+func Parse(value string) (Amount, error) {
+	s := strings.TrimFunc(value, unicode.IsSpace)
+
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = strings.TrimFunc(s[1:len(s)-1], unicode.IsSpace)
+	}
+
+	// The minus sign may come before or after the symbol, but only once
+	if rest, ok := cutMinus(s); ok {
+		if negative {
+			return 0, ErrInvalidAmount
+		}
+		negative = true
+		s = rest
+	}
+	if rest, ok := strings.CutPrefix(s, symbol); ok {
+		s = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest, ok := cutMinus(s); ok {
+			if negative {
+				return 0, ErrInvalidAmount
+			}
+			negative = true
+			s = rest
+		}
+	}
+
+	centavos, err := parseNumber(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if negative {
+		centavos = -centavos
+	}
+
+	return Amount(centavos), nil
+}
+
+// ParseText reads an amount written out in Portuguese, the reverse of
+// ConvertRealToText and Amount.Text: "cento e dez reais e cinquenta
+// centavos" is 11050 centavos. The reais, the centavos or both may be given,
+// and the number words are read as numwords.Parse does, so "um milhão e meio
+// de reais" is accepted.
+// Returns ErrInvalidAmount if the text is not an amount in reais or has
+// more than 99 centavos, the error of numwords.Parse for invalid number
+// words, or ErrOutOfRange.
+func ParseText(text string) (Amount, error) {
+	words := numwords.Words(text)
+
+	negative := len(words) > 0 && words[0] == "menos"
+	if negative {
+		words = words[1:]
+	}
+
+	reaisWords, centavosWords := []string(nil), words
+	for index, word := range words {
+		if word == "real" || word == "reais" {
+			reaisWords, centavosWords = words[:index], words[index+1:]
+			break
+		}
+	}
+
+	if reaisWords != nil {
+		// "um milhão de reais"
+		if last := len(reaisWords) - 1; last > 0 && reaisWords[last] == "de" {
+			reaisWords = reaisWords[:last]
+		}
+		if len(centavosWords) > 0 && centavosWords[0] == "e" {
+			centavosWords = centavosWords[1:]
+		}
+	}
+
+	var centavos int64
+	if len(centavosWords) > 0 {
+		last := len(centavosWords) - 1
+		if last == 0 || (centavosWords[last] != "centavo" && centavosWords[last] != "centavos") {
+			return 0, ErrInvalidAmount
+		}
+
+		var err error
+		centavos, err = numwords.Parse(strings.Join(centavosWords[:last], " "))
+		if err != nil {
+			return 0, err
+		}
+		if centavos < 0 || centavos > 99 {
+			return 0, ErrInvalidAmount
+		}
+	} else if reaisWords == nil {
+		return 0, ErrInvalidAmount
+	}
+
+	if len(reaisWords) > 0 {
+		reais, err := numwords.Parse(strings.Join(reaisWords, " "))
+		if err != nil {
+			return 0, err
+		}
+		if reais < 0 {
+			return 0, ErrInvalidAmount
+		}
+		if reais > (math.MaxInt64-centavos)/100 {
+			return 0, ErrOutOfRange
+		}
+		centavos += reais * 100
+	} else if reaisWords != nil {
+		return 0, ErrInvalidAmount
+	}
+
+	if negative {
+		if centavos == 0 {
+			// As in numwords.Parse, zero is not written with "menos"
+			return 0, ErrInvalidAmount
+		}
+		centavos = -centavos
+	}
+
+	return Amount(centavos), nil
+}
+
+// cutMinus removes a leading hyphen or Unicode minus sign, and the spaces
+// after it
+func cutMinus(s string) (string, bool) {
+	for _, minus := range []string{"-", "−"} {
+		if rest, ok := strings.CutPrefix(s, minus); ok {
+			return strings.TrimLeftFunc(rest, unicode.IsSpace), true
+		}
+	}
+	return s, false
+}
+
+// parseNumber reads an unsigned number with dots grouping the thousands and
+// an optional comma followed by 1 or 2 decimals, and returns it in centavos
+func parseNumber(s string) (int64, error) {
+	reais, decimals, hasComma := strings.Cut(s, ",")
+	if reais == "" || (hasComma && !isDigits(decimals)) {
+		return 0, ErrInvalidAmount
+	}
+
+	groups := strings.Split(reais, ".")
+	for index, group := range groups {
+		if !isDigits(group) {
+			return 0, ErrInvalidAmount
+		}
+		if len(groups) > 1 && (len(group) > 3 || (index > 0 && len(group) != 3)) {
+			return 0, ErrInvalidGrouping
+		}
+	}
+
+	switch {
+	case len(groups) == 2 && !hasComma:
+		return 0, ErrAmbiguousAmount
+	case len(decimals) == 3 && len(groups) == 1:
+		return 0, ErrAmbiguousAmount
+	case len(decimals) > 2:
+		return 0, ErrTooManyDecimals
+	}
+
+	centavos, err := strconv.ParseInt(strings.Join(groups, "")+(decimals + "00")[:2], 10, 64)
+	if err != nil {
+		return 0, ErrOutOfRange
+	}
+
+	return centavos, nil
+}
+
+func isDigits(s string) bool {
+	return s != "" && helpers.ContainsOnly(s, "0123456789")
+}
@@ -0,0 +1,134 @@
+package currency_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/currency"
+	"github.com/brazilian-utils/go/numwords"
+)
+
+var parseTests = []struct {
+	input    string
+	expected currency.Amount
+}{
+	{"R$ 1.234,56", 123456},
+	{"1234,56", 123456},
+	{"-R$ 10,00", -1000},
+	{"R$ -10,00", -1000},
+	{"(R$ 10,00)", -1000},
+	{"R$\u00a01.234,56", 123456},
+	{"-R$\u00a010,00", -1000},
+	{"\u2212R$\u00a010,00", -1000},
+	{"R$\u202f1.234,56", 123456},
+	{"  R$1.000.000,00 ", 100000000},
+	{"R$ 0,99", 99},
+	{"10,5", 1050},
+	{"10", 1000},
+	{"1.234.567", 123456700},
+	{"(1.234,56)", -123456},
+	{"- 5,00", -500},
+	{"0", 0},
+}
+
+func TestParse(t *testing.T) {
+	for _, table := range parseTests {
+		res, err := currency.Parse(table.input)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %q \t Expected: %v | Received: %v (%v)", table.input, table.expected, res, err)
+		}
+	}
+}
+
+var parseErrorTests = []struct {
+	input    string
+	expected error
+}{
+	{"", currency.ErrInvalidAmount},
+	{"R$", currency.ErrInvalidAmount},
+	{"abc", currency.ErrInvalidAmount},
+	{"10,", currency.ErrInvalidAmount},
+	{",50", currency.ErrInvalidAmount},
+	{"1,2,3", currency.ErrInvalidAmount},
+	{"10 00", currency.ErrInvalidAmount},
+	{"USD 10,00", currency.ErrInvalidAmount},
+	{"-R$ -10,00", currency.ErrInvalidAmount},
+	{"(-10,00)", currency.ErrInvalidAmount},
+	{"1.234", currency.ErrAmbiguousAmount},
+	{"1,234", currency.ErrAmbiguousAmount},
+	{"R$ 1.23", currency.ErrInvalidGrouping},
+	{"1234.567,00", currency.ErrInvalidGrouping},
+	{"1.2345,00", currency.ErrInvalidGrouping},
+	{"1,2345", currency.ErrTooManyDecimals},
+	{"1.234,567", currency.ErrTooManyDecimals},
+	{"R$ 92.233.720.368.547.758,08", currency.ErrOutOfRange},
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, table := range parseErrorTests {
+		if _, err := currency.Parse(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %q \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+var parseTextTests = []struct {
+	input    string
+	expected currency.Amount
+}{
+	{"cento e dez reais e cinquenta centavos", 11050},
+	{"Mil, quinhentos e vinte e três reais e quarenta e cinco centavos", 152345},
+	{"um real", 100},
+	{"UM REAL E UM CENTAVO", 101},
+	{"cinquenta centavos", 50},
+	{"zero reais", 0},
+	{"um milhão de reais", 100000000},
+	{"um milhão e meio de reais", 150000000},
+	{"dois mil reais e dez centavos", 200010},
+	{"catorze reais", 1400},
+	{"Menos cinco reais e vinte e cinco centavos", -525},
+	{"mil duzentos e trinta e quatro reais", 123400},
+}
+
+func TestParseText(t *testing.T) {
+	for _, table := range parseTextTests {
+		res, err := currency.ParseText(table.input)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.expected.Centavos(), res.Centavos(), err)
+		}
+	}
+}
+
+func TestParseTextRoundTrip(t *testing.T) {
+	for _, amount := range []currency.Amount{1, 99, 100, 101, 123456, -987654321, 100000000, 150000000, 100000000000000} {
+		if res, err := currency.ParseText(amount.Text()); err != nil || res != amount {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", amount.Text(), amount.Centavos(), res.Centavos(), err)
+		}
+	}
+}
+
+var parseTextErrorTests = []struct {
+	input    string
+	expected error
+}{
+	{"", currency.ErrInvalidAmount},
+	{"reais", currency.ErrInvalidAmount},
+	{"cinquenta", currency.ErrInvalidAmount},
+	{"dez reais e centavos", currency.ErrInvalidAmount},
+	{"dez reais e cinquenta", currency.ErrInvalidAmount},
+	{"cento e dez centavos", currency.ErrInvalidAmount},
+	{"menos dez centavos e um real", numwords.ErrUnknownWord},
+	{"vinte trinta reais", numwords.ErrInvalidNumber},
+	{"menos zero reais", currency.ErrInvalidAmount},
+	{"dez dolares", currency.ErrInvalidAmount},
+	{"vinte e hum reais", numwords.ErrUnknownWord},
+	{"noventa e três quatrilhões de reais", currency.ErrOutOfRange},
+}
+
+func TestParseTextErrors(t *testing.T) {
+	for _, table := range parseTextErrorTests {
+		if _, err := currency.ParseText(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %q \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
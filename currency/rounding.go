@@ -0,0 +1,151 @@
+package currency
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode sets how values with more than 2 decimal places become
+// centavos.
+type RoundingMode int
+
+// Supported rounding modes. They are symmetric: negative values are rounded
+// as their absolute value and keep their sign.
+const (
+	// RoundHalfUp rounds half a centavo away from zero, as in commercial
+	// rounding: 2.675 becomes 2.68 and -2.675 becomes -2.68.
+	RoundHalfUp RoundingMode = iota
+	// RoundTruncate discards the extra decimals: 2.679 becomes 2.67.
+	RoundTruncate
+	// RoundHalfEven rounds half a centavo to the even centavo, as in banker's
+	// rounding: 2.675 becomes 2.68 and 2.665 becomes 2.66.
+	RoundHalfEven
+	// RoundABNT follows ABNT NBR 5891: discarded digits below 5 are dropped,
+	// above 5, or 5 followed by any non-zero digit, increment the last kept
+	// digit, and a lone 5 increments it only if it is odd. Applied once to the
+	// decimal value, these are the rules of RoundHalfEven, so it is an alias.
+	RoundABNT = RoundHalfEven
+)
+
+// roundDecimal returns an unsigned decimal number given by the digits of its
+// integer part and its decimals, as an integer number of units of its
+// places-th decimal place, rounded according to mode
+func roundDecimal(integer, decimals string, places int, mode RoundingMode) (int64, error) {
+	padded := decimals + strings.Repeat("0", places)
+	units, err := strconv.ParseInt(integer+padded[:places], 10, 64)
+	if err != nil {
+		return 0, ErrOutOfRange
+	}
+
+	if len(decimals) > places && mode.roundsUp(units%2 == 1, compareHalf(decimals[places:])) {
+		if units == math.MaxInt64 {
+			return 0, ErrOutOfRange
+		}
+		units++
+	}
+
+	return units, nil
+}
+
+// rescale converts an unsigned number of units of the from-th decimal place
+// into units of the to-th decimal place, rounding according to mode
+func rescale(units uint64, from, to int, mode RoundingMode) (uint64, error) {
+	for ; from < to; from++ {
+		hi, lo := bits.Mul64(units, 10)
+		if hi != 0 {
+			return 0, ErrOutOfRange
+		}
+		units = lo
+	}
+
+	var divisor uint64 = 1
+	for ; from > to; from-- {
+		divisor *= 10
+	}
+
+	quotient, remainder := units/divisor, units%divisor
+	if remainder > 0 && mode.roundsUp(quotient%2 == 1, compareUint64(2*remainder, divisor)) {
+		quotient++
+	}
+
+	return quotient, nil
+}
+
+// compareHalf returns -1, 0 or 1 as the discarded decimal digits are less
+// than, equal to or greater than half a unit of the last kept digit
+func compareHalf(discarded string) int {
+	switch {
+	case discarded[0] < '5':
+		return -1
+	case discarded[0] > '5' || strings.Trim(discarded[1:], "0") != "":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// roundsUp reports whether the discarded part carries to the last centavo,
+// given whether that centavo is odd and how the discarded part compares to
+// half a centavo
+func (mode RoundingMode) roundsUp(odd bool, half int) bool {
+	switch mode {
+	case RoundTruncate:
+		return false
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd)
+	default:
+		return half >= 0
+	}
+}
+
+// MulRatio returns the amount multiplied by numerator/denominator, rounded
+// to centavos according to mode, as in a discount, a fee or interest:
+// a.MulRatio(15, 1000, RoundABNT) is 1.5% of a. The product is computed
+// exactly, without overflowing in the intermediate steps.
+// Returns ErrInvalidRatio if denominator is not positive, or ErrOutOfRange if
+// the result does not fit in an Amount.
+func (a Amount) MulRatio(numerator, denominator int64, mode RoundingMode) (Amount, error) {
+	if denominator <= 0 {
+		return 0, ErrInvalidRatio
+	}
+
+	negative := (a < 0) != (numerator < 0)
+	hi, lo := bits.Mul64(absUint64(int64(a)), absUint64(numerator))
+	if hi >= uint64(denominator) {
+		return 0, ErrOutOfRange
+	}
+
+	quotient, remainder := bits.Div64(hi, lo, uint64(denominator))
+	half := compareUint64(2*remainder, uint64(denominator))
+	if remainder > 0 && mode.roundsUp(quotient%2 == 1, half) {
+		quotient++
+	}
+	if quotient > math.MaxInt64 {
+		return 0, ErrOutOfRange
+	}
+
+	if negative {
+		return Amount(-int64(quotient)), nil
+	}
+	return Amount(quotient), nil
+}
+
+func absUint64(value int64) uint64 {
+	if value < 0 {
+		return -uint64(value)
+	}
+	return uint64(value)
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,80 @@
+package currency_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brazilian-utils/go/currency"
+)
+
+var roundingTests = []struct {
+	input    float64
+	halfUp   currency.Amount
+	truncate currency.Amount
+	halfEven currency.Amount
+}{
+	{2.675, 268, 267, 268},
+	{2.665, 267, 266, 266},
+	{2.6651, 267, 266, 267},
+	{2.6649, 266, 266, 266},
+	{2.669, 267, 266, 267},
+	{-2.665, -267, -266, -266},
+	{0.005, 1, 0, 0},
+	{0.015, 2, 1, 2},
+}
+
+func TestRoundingModes(t *testing.T) {
+	for _, table := range roundingTests {
+		modes := []struct {
+			mode     currency.RoundingMode
+			expected currency.Amount
+		}{
+			{currency.RoundHalfUp, table.halfUp},
+			{currency.RoundTruncate, table.truncate},
+			{currency.RoundHalfEven, table.halfEven},
+		}
+		for _, mode := range modes {
+			if res, err := currency.FromFloat(table.input, mode.mode); err != nil || res != mode.expected {
+				t.Errorf("Failing for %v in mode %v \t Expected: %v | Received: %v (%v)", table.input, mode.mode, mode.expected.Centavos(), res.Centavos(), err)
+			}
+		}
+	}
+}
+
+var mulRatioTests = []struct {
+	input       currency.Amount
+	numerator   int64
+	denominator int64
+	mode        currency.RoundingMode
+	expected    currency.Amount
+}{
+	{10000, 15, 1000, currency.RoundABNT, 150},
+	{1050, 1, 4, currency.RoundHalfUp, 263},
+	{1050, 1, 4, currency.RoundHalfEven, 262},
+	{1050, 1, 4, currency.RoundTruncate, 262},
+	{1030, 1, 4, currency.RoundHalfEven, 258},
+	{-1050, 1, 4, currency.RoundHalfUp, -263},
+	{1050, -1, 4, currency.RoundHalfUp, -263},
+	{1000, 1, 3, currency.RoundHalfUp, 333},
+	{2000, 1, 3, currency.RoundHalfUp, 667},
+	{math.MaxInt64, 3, 3, currency.RoundHalfUp, math.MaxInt64},
+}
+
+func TestMulRatio(t *testing.T) {
+	for _, table := range mulRatioTests {
+		res, err := table.input.MulRatio(table.numerator, table.denominator, table.mode)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v * %v/%v \t Expected: %v | Received: %v (%v)", table.input.Centavos(), table.numerator, table.denominator, table.expected.Centavos(), res.Centavos(), err)
+		}
+	}
+}
+
+func TestMulRatioErrors(t *testing.T) {
+	if _, err := currency.FromCentavos(100).MulRatio(1, 0, currency.RoundHalfUp); !errors.Is(err, currency.ErrInvalidRatio) {
+		t.Errorf("Expected ErrInvalidRatio, got %v", err)
+	}
+	if _, err := currency.FromCentavos(math.MaxInt64).MulRatio(2, 1, currency.RoundHalfUp); !errors.Is(err, currency.ErrOutOfRange) {
+		t.Errorf("Expected ErrOutOfRange, got %v", err)
+	}
+}
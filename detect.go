@@ -0,0 +1,74 @@
+package brutils
+
+import (
+	"strings"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Match is a document type whose validation passed for a detected input.
+type Match struct {
+	Type DocumentType
+	// Value is the input normalized for the document type, without
+	// formatting symbols.
+	Value string
+}
+
+// detector describes how to recognize a document type in free text.
+type detector struct {
+	documentType DocumentType
+	normalize    func(input string) string
+}
+
+// detectors are listed from the most to the least reliable checksum, which is
+// the order matches are ranked in when the input formatting gives no hint.
+var detectors = []detector{
+	{Boleto, helpers.OnlyNumbers},
+	{LegalProcess, helpers.OnlyNumbers},
+	{CNPJ, normalizeAlphanumeric},
+	{CPF, helpers.OnlyNumbers},
+	{VoterID, helpers.OnlyNumbers},
+	{CNH, helpers.OnlyNumbers},
+	{PIS, helpers.OnlyNumbers},
+	{RENAVAM, helpers.OnlyNumbers},
+	{CEP, helpers.OnlyNumbers},
+}
+
+// Detect returns every document type whose validation passes for the given
+// identifier, with its normalized value. Several types may match, since
+// many documents share the same length, so matches are ranked: types whose
+// standard formatting is exactly the input come first, followed by the
+// others from the most to the least reliable checksum.
+// Validators and formatters are taken from the registry.
+// Returns nil if no document type matches.
+func Detect(input string) []Match {
+	input = strings.TrimSpace(input)
+
+	var formatted, others []Match
+	for _, d := range detectors {
+		validator, ok := ValidatorFor(d.documentType)
+		normalized := d.normalize(input)
+		if !ok || normalized == "" || !validator.Validate(normalized) {
+			continue
+		}
+
+		match := Match{Type: d.documentType, Value: normalized}
+		if isFormatted(d.documentType, input, normalized) {
+			formatted = append(formatted, match)
+		} else {
+			others = append(others, match)
+		}
+	}
+
+	return append(formatted, others...)
+}
+
+// isFormatted reports whether input is the standard formatting of normalized.
+func isFormatted(documentType DocumentType, input string, normalized string) bool {
+	formatter, ok := FormatterFor(documentType)
+	return ok && normalized != input && formatter.Format(normalized) == input
+}
+
+func normalizeAlphanumeric(input string) string {
+	return strings.ToUpper(helpers.OnlyAlphanumerics(input))
+}
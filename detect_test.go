@@ -0,0 +1,65 @@
+package brutils_test
+
+import (
+	"testing"
+
+	brutils "github.com/brazilian-utils/go"
+)
+
+var detectTests = []struct {
+	input    string
+	expected brutils.DocumentType
+	value    string
+}{
+	{"403.644.788-29", brutils.CPF, "40364478829"},
+	{"11.222.333/0001-81", brutils.CNPJ, "11222333000181"},
+	{"12.abc.345/01de-35", brutils.CNPJ, "12ABC34501DE35"},
+	{"01001-000", brutils.CEP, "01001000"},
+	{"123.45678.90-0", brutils.PIS, "12345678900"},
+	{"6908 4709 28 28", brutils.VoterID, "690847092828"},
+	{"6847650-60.2023.3.03.0000", brutils.LegalProcess, "68476506020233030000"},
+	{"00190000090114971860168524522114675860000102656", brutils.Boleto, "00190000090114971860168524522114675860000102656"},
+}
+
+func TestDetect(t *testing.T) {
+	for _, table := range detectTests {
+		matches := brutils.Detect(table.input)
+		if len(matches) == 0 {
+			t.Errorf("Failing for %v \t Expected: %v | Received no matches", table.input, table.expected)
+			continue
+		}
+		if matches[0].Type != table.expected || matches[0].Value != table.value {
+			t.Errorf("Failing for %v \t Expected: %v %v | Received: %v", table.input, table.expected, table.value, matches)
+		}
+	}
+}
+
+func TestDetectAmbiguous(t *testing.T) {
+	// 98765432100 is both a valid CPF and a valid CNH
+	matches := brutils.Detect("98765432100")
+
+	types := make(map[brutils.DocumentType]bool)
+	for _, match := range matches {
+		types[match.Type] = true
+	}
+	if !types[brutils.CPF] || !types[brutils.CNH] {
+		t.Errorf("Expected CPF and CNH matches, got %v", matches)
+	}
+	if matches[0].Type != brutils.CPF {
+		t.Errorf("Expected CPF to rank first, got %v", matches)
+	}
+
+	// 86769597308 is both a valid PIS and a valid RENAVAM
+	matches = brutils.Detect(" 86769597308 ")
+	if len(matches) != 2 || matches[0].Type != brutils.PIS || matches[1].Type != brutils.RENAVAM {
+		t.Errorf("Expected PIS and RENAVAM matches, got %v", matches)
+	}
+}
+
+func TestDetectNoMatch(t *testing.T) {
+	for _, input := range []string{"", "abc", "123"} {
+		if matches := brutils.Detect(input); len(matches) != 0 {
+			t.Errorf("Expected no matches for %q, got %v", input, matches)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package email
+
+import (
+	"log/slog"
+	"strings"
+)
+
+// Mask returns the email address with all but the first character of its
+// local part and of its domain hidden, keeping the domain suffix, e.g.
+// "j***@d***.com" for "joao@dominio.com". The number of hidden characters
+// is not revealed. Returns an empty string if the email address is invalid.
+func Mask(email string) string {
+	return MaskRange(email, 1, 1)
+}
+
+// MaskRange returns the email address keeping the first localVisible
+// characters of its local part and the first domainVisible characters of
+// its domain visible, followed by "***". The domain suffix (from its first
+// dot) is always kept. Returns an empty string if the email address is
+// invalid.
+func MaskRange(email string, localVisible int, domainVisible int) string {
+	if !IsValid(email) {
+		return ""
+	}
+
+	at := strings.LastIndex(email, "@")
+	local, domain := email[:at], email[at+1:]
+
+	dot := strings.Index(domain, ".")
+	name, suffix := domain[:dot], domain[dot:]
+
+	return prefix(local, localVisible) + "***@" + prefix(name, domainVisible) + "***" + suffix
+}
+
+// Masked is an email address that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
+
+// prefix returns the first n characters of s, or s if it is shorter.
+func prefix(s string, n int) string {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(s) {
+		n = len(s)
+	}
+
+	return s[:n]
+}
@@ -0,0 +1,43 @@
+package email_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/email"
+)
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"joao@dominio.com", "j***@d***.com"},
+	{"user.name@domain.com.br", "u***@d***.com.br"},
+	{"a@b.co", "a***@b***.co"},
+	{"invalid", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := email.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+func TestMaskRange(t *testing.T) {
+	if res := email.MaskRange("joao@dominio.com", 2, 0); res != "jo***@***.com" {
+		t.Errorf("Expected %v, got %v", "jo***@***.com", res)
+	}
+}
+
+func TestMaskedLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("test", "value", email.Masked("joao@dominio.com"))
+
+	if !strings.Contains(buf.String(), `value=`+`j***@d***.com`) {
+		t.Errorf("Expected masked value in log, got %v", buf.String())
+	}
+}
@@ -12,3 +12,11 @@ var emailRegex = regexp.MustCompile(
 func IsValid(email string) bool {
 	return emailRegex.MatchString(email)
 }
+
+// Validator implements the brutils.Validator interface for email addresses using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
+}
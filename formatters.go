@@ -3,3 +3,11 @@ package brutils
 type Formatter interface {
 	Format(input string) string
 }
+
+// FormatterFunc adapts a formatting function to the Formatter interface.
+type FormatterFunc func(input string) string
+
+// Format calls f(input).
+func (f FormatterFunc) Format(input string) string {
+	return f(input)
+}
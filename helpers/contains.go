@@ -1,5 +1,7 @@
 package helpers
 
+import "strings"
+
 // Contains verifies if a slice of string contains the given string
 func Contains(h []string, n string) bool {
 	for _, s := range h {
@@ -21,3 +23,15 @@ func ContainsInt(sl []int, v int) bool {
 
 	return false
 }
+
+// ContainsOnly verifies if every character of the given string
+// is one of the allowed characters
+func ContainsOnly(s string, allowed string) bool {
+	for _, c := range s {
+		if !strings.ContainsRune(allowed, c) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -44,3 +44,22 @@ func TestContaintsInt(t *testing.T) {
 		}
 	}
 }
+
+func TestContainsOnly(t *testing.T) {
+	tables := []struct {
+		input    string
+		expected bool
+	}{
+		{"", true},
+		{"123", true},
+		{"1.2-3", true},
+		{"12a", false},
+		{"1_2", false},
+	}
+
+	for _, table := range tables {
+		if res := helpers.ContainsOnly(table.input, "0123456789.-"); res != table.expected {
+			t.Fatalf("Failed for %s (expected: %t, received: %t)", table.input, table.expected, res)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package helpers
+
+// MaskCharacter replaces the hidden characters of masked values
+const MaskCharacter = '*'
+
+// Mask replaces every character of value with MaskCharacter, except
+// those whose index is inside one of the visible [start, end) ranges
+func Mask(value string, visible ...[2]int) string {
+	runes := []rune(value)
+	for i := range runes {
+		if !isVisible(i, visible) {
+			runes[i] = MaskCharacter
+		}
+	}
+
+	return string(runes)
+}
+
+func isVisible(index int, visible [][2]int) bool {
+	for _, r := range visible {
+		if index >= r[0] && index < r[1] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,27 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+func TestMask(t *testing.T) {
+	tables := []struct {
+		input    string
+		visible  [][2]int
+		expected string
+	}{
+		{"", nil, ""},
+		{"40364478829", nil, "***********"},
+		{"40364478829", [][2]int{{3, 9}}, "***644788**"},
+		{"11994029275", [][2]int{{0, 2}, {7, 11}}, "11*****9275"},
+		{"123", [][2]int{{1, 10}}, "*23"},
+	}
+
+	for _, table := range tables {
+		if res := helpers.Mask(table.input, table.visible...); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
@@ -11,3 +11,10 @@ func OnlyNumbers(numbers string) string {
 	numericStr := regexp.MustCompilePOSIX("[0-9]+").FindAllString(numbers, -1)
 	return strings.Join(numericStr[:], "")
 }
+
+// OnlyAlphanumerics removes all characters that are
+// not ASCII letters or digits from a string
+func OnlyAlphanumerics(value string) string {
+	alphanumericStr := regexp.MustCompilePOSIX("[0-9A-Za-z]+").FindAllString(value, -1)
+	return strings.Join(alphanumericStr[:], "")
+}
@@ -20,3 +20,22 @@ func TestOnlyNumbers(t *testing.T) {
 		}
 	}
 }
+
+func TestOnlyAlphanumerics(t *testing.T) {
+	tables := []struct {
+		input  string
+		output string
+	}{
+		{"", ""},
+		{"test", "test"},
+		{"12.ABC.345/01DE-35", "12ABC34501DE35"},
+		{"ç-á 1", "1"},
+	}
+
+	for _, table := range tables {
+		formated := OnlyAlphanumerics(table.input)
+		if formated != table.output {
+			t.Errorf("Ouput invalid, given %v, expected %v", formated, table.output)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumberToPortuguese(t *testing.T) {
+	tables := []struct {
+		input  int64
+		output string
+	}{
+		{0, "zero"},
+		{1, "um"},
+		{1523, "mil, quinhentos e vinte e três"},
+		{-2, "menos dois"},
+		{math.MinInt64, "menos nove quintilhões, duzentos e vinte e três quatrilhões, trezentos e setenta e dois trilhões, trinta e seis bilhões, oitocentos e cinquenta e quatro milhões, setecentos e setenta e cinco mil, oitocentos e oito"},
+	}
+
+	for _, table := range tables {
+		text := NumberToPortuguese(table.input)
+		if text != table.output {
+			t.Errorf("Output invalid, given %v, expected %v", text, table.output)
+		}
+	}
+}
+
+func TestParsePortuguese(t *testing.T) {
+	tables := []struct {
+		input  string
+		output int64
+	}{
+		{"zero", 0},
+		{"mil, quinhentos e vinte e três", 1523},
+		{"menos dois", -2},
+	}
+
+	for _, table := range tables {
+		n, err := ParsePortuguese(table.input)
+		if err != nil || n != table.output {
+			t.Errorf("Output invalid, given %v (%v), expected %v", n, err, table.output)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package numtext
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Errors returned by Parse, exported again by the numwords package. They
+// can be compared with errors.Is.
+var (
+	ErrInvalidNumber = errors.New("numwords: invalid number")
+	ErrUnknownWord   = errors.New("numwords: unknown word")
+	ErrOutOfRange    = errors.New("numwords: number out of range")
+)
+
+// Words of 0-999 without accents, including the feminine and the variant
+// spellings, and their values
+var groupValues = map[string]uint64{
+	"zero": 0, "um": 1, "uma": 1, "dois": 2, "duas": 2, "tres": 3,
+	"quatro": 4, "cinco": 5, "seis": 6, "sete": 7, "oito": 8, "nove": 9,
+	"dez": 10, "onze": 11, "doze": 12, "treze": 13, "quatorze": 14,
+	"catorze": 14, "quinze": 15, "dezesseis": 16, "dezasseis": 16,
+	"dezessete": 17, "dezassete": 17, "dezoito": 18, "dezenove": 19,
+	"dezanove": 19,
+
+	"vinte": 20, "trinta": 30, "quarenta": 40, "cinquenta": 50,
+	"cincoenta": 50, "sessenta": 60, "setenta": 70, "oitenta": 80,
+	"noventa": 90,
+
+	"cem": 100, "cento": 100, "duzentos": 200, "trezentos": 300,
+	"quatrocentos": 400, "quinhentos": 500, "seiscentos": 600,
+	"setecentos": 700, "oitocentos": 800, "novecentos": 900,
+
+	"primeiro": 1, "segundo": 2, "terceiro": 3, "quarto": 4, "quinto": 5,
+	"sexto": 6, "setimo": 7, "oitavo": 8, "nono": 9,
+	"decimo": 10, "vigesimo": 20, "trigesimo": 30, "quadragesimo": 40,
+	"quinquagesimo": 50, "sexagesimo": 60, "septuagesimo": 70,
+	"setuagesimo": 70, "octogesimo": 80, "nonagesimo": 90,
+	"centesimo": 100, "ducentesimo": 200, "trecentesimo": 300,
+	"tricentesimo": 300, "quadringentesimo": 400, "quingentesimo": 500,
+	"sexcentesimo": 600, "seiscentesimo": 600, "septingentesimo": 700,
+	"setingentesimo": 700, "octingentesimo": 800, "nongentesimo": 900,
+	"noningentesimo": 900,
+}
+
+// Scale words without accents, cardinal and ordinal, and their values
+var scaleValues = map[string]uint64{
+	"mil": 1_000, "milesimo": 1_000,
+	"milhao": 1_000_000, "milhoes": 1_000_000, "milionesimo": 1_000_000,
+	"bilhao": 1_000_000_000, "bilhoes": 1_000_000_000, "bilionesimo": 1_000_000_000,
+	"trilhao": 1_000_000_000_000, "trilhoes": 1_000_000_000_000, "trilionesimo": 1_000_000_000_000,
+	"quatrilhao": 1_000_000_000_000_000, "quatrilhoes": 1_000_000_000_000_000, "quatrilionesimo": 1_000_000_000_000_000,
+	"quintilhao": 1_000_000_000_000_000_000, "quintilhoes": 1_000_000_000_000_000_000, "quintilionesimo": 1_000_000_000_000_000_000,
+}
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e", "í", "i",
+	"ó", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ü", "u", "ç", "c",
+)
+
+// Parse reads an integer written out in Portuguese, the reverse of Cardinal
+// with an optional leading "menos". See numwords.Parse for the accepted forms.
+func Parse(text string) (int64, error) {
+	words := Words(text)
+	negative := len(words) > 0 && words[0] == MinusWord
+	if negative {
+		words = words[1:]
+	}
+
+	// The magnitude of math.MinInt64 is one more than math.MaxInt64
+	maxValue := uint64(math.MaxInt64)
+	if negative {
+		maxValue++
+	}
+
+	n, err := parseWords(words, maxValue)
+	if err != nil {
+		return 0, err
+	}
+
+	if negative {
+		if n == 0 {
+			// "menos zero" is not how zero is written
+			return 0, ErrInvalidNumber
+		}
+		return int64(-n), nil
+	}
+	return int64(n), nil
+}
+
+// Words splits a text in lowercase words without accents, dropping
+// punctuation, as Parse reads them.
+func Words(text string) []string {
+	text = accentReplacer.Replace(strings.ToLower(text))
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
+
+// parseWords adds up the groups of 0-999 multiplied by the scales that
+// follow them, checking that the groups go from hundreds to units and the
+// scales decrease, up to maxValue
+func parseWords(words []string, maxValue uint64) (uint64, error) {
+	if len(words) == 1 && words[0] == "zero" {
+		return 0, nil
+	}
+
+	var total, group uint64
+	var lastScale uint64 = math.MaxUint64
+	limit := uint64(1000) // the next group word must be below it
+	seen, done := false, false
+
+	for _, word := range words {
+		if word == "e" {
+			continue
+		}
+		if done {
+			return 0, ErrInvalidNumber
+		}
+
+		if value, ok := lookup(groupValues, word); ok && word != "zero" {
+			if value >= limit {
+				return 0, ErrInvalidNumber
+			}
+			group += value
+			seen = true
+
+			switch {
+			case strings.HasPrefix(word, "decim"):
+				// Unlike "dez", "décimo" takes the units after it
+				limit = 10
+			case word == "cem" || value < 20 || value%10 != 0:
+				limit = 1
+			case value < 100:
+				limit = 10
+			default:
+				limit = 100
+			}
+			continue
+		}
+
+		if scale, ok := lookup(scaleValues, word); ok {
+			if scale >= lastScale {
+				return 0, ErrInvalidNumber
+			}
+			if group == 0 {
+				// "mil" and "milésimo" have no "um" before them
+				group = 1
+			}
+			if group > (maxValue-total)/scale {
+				return 0, ErrOutOfRange
+			}
+			total += group * scale
+			group, lastScale, limit = 0, scale, 1000
+			seen = true
+			continue
+		}
+
+		if word == "meio" || word == "meia" {
+			// Half of the last scale, as in "um milhão e meio"
+			if group != 0 || lastScale == math.MaxUint64 {
+				return 0, ErrInvalidNumber
+			}
+			total += lastScale / 2
+			done = true
+			continue
+		}
+
+		if _, ok := groupValues[word]; ok || word == MinusWord {
+			return 0, ErrInvalidNumber
+		}
+		return 0, fmt.Errorf("%w %q", ErrUnknownWord, word)
+	}
+
+	if !seen {
+		return 0, ErrInvalidNumber
+	}
+	if group > maxValue-total {
+		return 0, ErrOutOfRange
+	}
+
+	return total + group, nil
+}
+
+// lookup finds a word in a table, also in the feminine or the plural:
+// "duzentas", "vigesima" and "milesimos" are found as "duzentos", "vigesimo"
+// and "milesimo"
+func lookup(table map[string]uint64, word string) (uint64, bool) {
+	singular := strings.TrimSuffix(word, "s")
+	candidates := []string{word, singular}
+	if base, ok := strings.CutSuffix(word, "as"); ok {
+		candidates = append(candidates, base+"os")
+	}
+	if base, ok := strings.CutSuffix(singular, "a"); ok {
+		candidates = append(candidates, base+"o")
+	}
+
+	for _, candidate := range candidates {
+		if value, ok := table[candidate]; ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
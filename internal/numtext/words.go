@@ -0,0 +1,215 @@
+// Package numtext holds the Portuguese number words and the rules to write
+// and read them, shared by the numwords and helpers packages.
+package numtext
+
+import "strings"
+
+// MinusWord comes before the words of a negative number
+const MinusWord = "menos"
+
+var onesWords = []string{
+	"zero", "um", "dois", "três", "quatro", "cinco",
+	"seis", "sete", "oito", "nove", "dez",
+	"onze", "doze", "treze", "quatorze", "quinze",
+	"dezesseis", "dezessete", "dezoito", "dezenove",
+}
+
+var tensWords = []string{
+	"", "", "vinte", "trinta", "quarenta", "cinquenta",
+	"sessenta", "setenta", "oitenta", "noventa",
+}
+
+var hundredsWords = []string{
+	"", "cento", "duzentos", "trezentos", "quatrocentos", "quinhentos",
+	"seiscentos", "setecentos", "oitocentos", "novecentos",
+}
+
+// Feminine forms of "um" and "dois"; the other units and the tens do not
+// change with gender, and the hundreds change "-os" to "-as"
+var onesFeminineWords = map[int]string{1: "uma", 2: "duas"}
+
+var onesOrdinals = []string{
+	"", "primeiro", "segundo", "terceiro", "quarto",
+	"quinto", "sexto", "sétimo", "oitavo", "nono",
+}
+
+var tensOrdinals = []string{
+	"", "décimo", "vigésimo", "trigésimo", "quadragésimo", "quinquagésimo",
+	"sexagésimo", "septuagésimo", "octogésimo", "nonagésimo",
+}
+
+var hundredsOrdinals = []string{
+	"", "centésimo", "ducentésimo", "trecentésimo", "quadringentésimo", "quingentésimo",
+	"sexcentésimo", "septingentésimo", "octingentésimo", "nongentésimo",
+}
+
+type scaleUnit struct {
+	singular string
+	plural   string
+	ordinal  string
+	value    uint64
+}
+
+var scales = []scaleUnit{
+	{"quintilhão", "quintilhões", "quintilionésimo", 1_000_000_000_000_000_000},
+	{"quatrilhão", "quatrilhões", "quatrilionésimo", 1_000_000_000_000_000},
+	{"trilhão", "trilhões", "trilionésimo", 1_000_000_000_000},
+	{"bilhão", "bilhões", "bilionésimo", 1_000_000_000},
+	{"milhão", "milhões", "milionésimo", 1_000_000},
+	{"mil", "mil", "milésimo", 1_000},
+}
+
+// convertGroup converts a number 0-999 to Portuguese words.
+func convertGroup(n int, feminine bool) string {
+	if n == 0 {
+		return ""
+	}
+	if n == 100 {
+		return "cem"
+	}
+
+	var parts []string
+
+	if n >= 100 {
+		hundreds := hundredsWords[n/100]
+		if feminine && n >= 200 {
+			hundreds = strings.TrimSuffix(hundreds, "os") + "as"
+		}
+		parts = append(parts, hundreds)
+		n %= 100
+	}
+
+	if n >= 20 {
+		parts = append(parts, tensWords[n/10])
+		n %= 10
+		if n > 0 {
+			parts = append(parts, onesWord(n, feminine))
+		}
+	} else if n > 0 {
+		parts = append(parts, onesWord(n, feminine))
+	}
+
+	return strings.Join(parts, " e ")
+}
+
+func onesWord(n int, feminine bool) string {
+	if word, ok := onesFeminineWords[n]; ok && feminine {
+		return word
+	}
+	return onesWords[n]
+}
+
+// ordinalGroup converts a number 1-999 to Portuguese ordinal words.
+func ordinalGroup(n int, feminine bool) string {
+	parts := []string{hundredsOrdinals[n/100], tensOrdinals[n/10%10], onesOrdinals[n%10]}
+
+	var words []string
+	for _, part := range parts {
+		if part != "" {
+			words = append(words, inflect(part, feminine))
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// inflect turns a masculine ordinal into the feminine one
+func inflect(ordinal string, feminine bool) string {
+	if feminine {
+		return strings.TrimSuffix(ordinal, "o") + "a"
+	}
+	return ordinal
+}
+
+// Cardinal converts a non-negative integer to Portuguese words. Counts of
+// millions and above are masculine, as "milhão" is a masculine noun, while
+// counts of thousands agree with the gender of what is counted.
+func Cardinal(n uint64, feminine bool) string {
+	if n == 0 {
+		return "zero"
+	}
+
+	type group struct {
+		text  string
+		value int // the 3-digit group count (0-999)
+	}
+
+	var groups []group
+
+	for _, s := range scales {
+		if n >= s.value {
+			count := int(n / s.value)
+			n %= s.value
+
+			scaleName := s.plural
+			if count == 1 {
+				scaleName = s.singular
+			}
+
+			// "mil" has no "um" prefix in Portuguese
+			if s.value == 1000 && count == 1 {
+				groups = append(groups, group{"mil", count})
+			} else {
+				groups = append(groups, group{convertGroup(count, feminine && s.value == 1000) + " " + scaleName, count})
+			}
+		}
+	}
+
+	// Units group (0-999)
+	if n > 0 {
+		groups = append(groups, group{convertGroup(int(n), feminine), int(n)})
+	}
+
+	if len(groups) == 1 {
+		return groups[0].text
+	}
+
+	// Join all groups: use ", " between groups, except the last
+	// connector uses " e " when the last group is < 100 or a round hundred.
+	lastIdx := len(groups) - 1
+	lastValue := groups[lastIdx].value
+	useE := lastValue < 100 || lastValue%100 == 0
+
+	var sb strings.Builder
+	for i := 0; i < lastIdx; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(groups[i].text)
+	}
+
+	if useE {
+		sb.WriteString(" e ")
+	} else {
+		sb.WriteString(", ")
+	}
+	sb.WriteString(groups[lastIdx].text)
+
+	return sb.String()
+}
+
+// Ordinal converts a positive integer to Portuguese ordinal words, without
+// connectors: 1234 is "milésimo ducentésimo trigésimo quarto". Multiples of
+// a scale are read as the cardinal count followed by the scale ordinal, as in
+// "dois milésimo".
+func Ordinal(n uint64, feminine bool) string {
+	var words []string
+
+	for _, s := range scales {
+		if n >= s.value {
+			count := n / s.value
+			n %= s.value
+
+			if count > 1 {
+				words = append(words, Cardinal(count, feminine && s.value == 1000))
+			}
+			words = append(words, inflect(s.ordinal, feminine))
+		}
+	}
+
+	if n > 0 {
+		words = append(words, ordinalGroup(int(n), feminine))
+	}
+
+	return strings.Join(words, " ")
+}
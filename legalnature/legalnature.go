@@ -92,6 +92,14 @@ func IsValid(code string) bool {
 	return ok
 }
 
+// Validator implements the brutils.Validator interface for Natureza Jurídica codes using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
+}
+
 // GetDescription retrieves the description of a Natureza Jurídica code.
 // Returns empty string if the code is not found.
 func GetDescription(code string) string {
@@ -1,6 +1,7 @@
 package legalprocess
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -8,6 +9,16 @@ import (
 	"time"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength     = errors.New("legalprocess: invalid length")
+	ErrInvalidCharacters = errors.New("legalprocess: invalid characters")
+	ErrInvalidSegment    = errors.New("legalprocess: unknown justice segment")
+	ErrInvalidTribunal   = errors.New("legalprocess: unknown tribunal code")
+	ErrInvalidForo       = errors.New("legalprocess: unknown foro code")
+	ErrInvalidCheckDigit = errors.New("legalprocess: invalid check digits")
+)
+
 const processSize = 20
 
 // randIntn wraps rand.Intn for use across the package.
@@ -16,10 +27,19 @@ var randIntn = rand.Intn
 // IsValid checks if a legal process ID is valid.
 // Validates format (20 digits), checksum, and orgão/tribunal/foro codes.
 func IsValid(legalProcessID string) bool {
+	return Validate(legalProcessID) == nil
+}
+
+// Validate validates a legal process ID and returns the reason why it is
+// invalid, or nil if it is valid.
+func Validate(legalProcessID string) error {
 	clean := removeSymbols(legalProcessID)
 
-	if len(clean) != processSize || !isDigits(clean) {
-		return false
+	if clean != "" && !isDigits(clean) {
+		return ErrInvalidCharacters
+	}
+	if len(clean) != processSize {
+		return ErrInvalidLength
 	}
 
 	dd := clean[7:9]
@@ -29,16 +49,30 @@ func IsValid(legalProcessID string) bool {
 
 	orgao, ok := orgaos[j]
 	if !ok {
-		return false
+		return ErrInvalidSegment
 	}
-
-	if !orgao.tribunals[tr] || !orgao.foros[oooo] {
-		return false
+	if !orgao.tribunals[tr] {
+		return ErrInvalidTribunal
+	}
+	if !orgao.foros[oooo] {
+		return ErrInvalidForo
 	}
 
 	// Checksum: base = NNNNNNN + YYYYJTTOOOO (digits without DD)
 	base := clean[0:7] + clean[9:20]
-	return checksum(base) == dd
+	if checksum(base) != dd {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// Validator implements the brutils.Validator interface for legal process IDs using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
 }
 
 // Format formats a 20-digit legal process ID into the standard
@@ -58,6 +92,14 @@ func Format(legalProcessID string) string {
 	)
 }
 
+// Formatter implements the brutils.Formatter interface for legal process IDs using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
 // Generate generates a random valid legal process ID.
 // year must not be in the past; orgao must be 1-9.
 // Returns empty string if arguments are invalid.
@@ -1,6 +1,7 @@
 package legalprocess_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -81,3 +82,24 @@ func TestGenerateInvalidArgs(t *testing.T) {
 		t.Errorf("Expected empty for orgao 10, got %v", res)
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"6847650-60.2023.3.03.0000", nil},
+	{"123", legalprocess.ErrInvalidLength},
+	{"0000000000000000000a", legalprocess.ErrInvalidCharacters},
+	{"68476506020230030000", legalprocess.ErrInvalidSegment},
+	{"68476506020233040000", legalprocess.ErrInvalidTribunal},
+	{"68476506020233030001", legalprocess.ErrInvalidForo},
+	{"68476506120233030000", legalprocess.ErrInvalidCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := legalprocess.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
@@ -24,6 +24,18 @@ func IsValid(plate string, plateType string) bool {
 	}
 }
 
+// Validator implements the brutils.Validator interface for license plates
+// using IsValid. Type is the plateType passed to IsValid: "old_format",
+// "mercosul", or "" to accept either.
+type Validator struct {
+	Type string
+}
+
+// Validate reports whether input is valid.
+func (v Validator) Validate(input string) bool {
+	return IsValid(input, v.Type)
+}
+
 // GetFormat returns the format of a license plate:
 // "LLLNNNN" for old format, "LLLNLNN" for Mercosul, or empty string if invalid.
 func GetFormat(plate string) string {
@@ -52,6 +64,14 @@ func Format(plate string) string {
 	return ""
 }
 
+// Formatter implements the brutils.Formatter interface for license plates using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
 // ConvertToMercosul converts an old format plate (LLLNNNN) to Mercosul (LLLNLNN).
 // The 5th character (second digit) is converted to a letter (0→A, 1→B, ..., 9→J).
 // Returns empty string if the input is not a valid old format plate.
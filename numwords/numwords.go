@@ -0,0 +1,237 @@
+// Package numwords writes numbers out in Brazilian Portuguese: cardinals and
+// ordinals, in the masculine or the feminine, decimals read with "vírgula",
+// percentages and amounts of arbitrary units.
+package numwords
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/brazilian-utils/go/internal/numtext"
+)
+
+// Errors returned by Format, FormatDecimal, Parse and the options. They can
+// be compared with errors.Is.
+var (
+	ErrInvalidNumber  = numtext.ErrInvalidNumber
+	ErrInvalidOrdinal = errors.New("numwords: ordinals must be positive integers")
+	ErrInvalidUnit    = errors.New("numwords: unit must have a singular and a plural form")
+	ErrUnknownWord    = numtext.ErrUnknownWord
+	ErrOutOfRange     = numtext.ErrOutOfRange
+)
+
+const (
+	minusWord   = numtext.MinusWord
+	decimalWord = "vírgula"
+	percentWord = "por cento"
+)
+
+// Option configures how numbers are written.
+type Option func(*options) error
+
+type options struct {
+	feminine bool
+	ordinal  bool
+	singular string
+	plural   string
+	percent  bool
+}
+
+// WithFeminine writes the number in the feminine, to agree with a feminine
+// noun: "uma", "duas", "duzentas e uma" or "vigésima primeira". Counts of
+// millions and above stay masculine, as in "dois milhões de parcelas".
+func WithFeminine() Option {
+	return func(o *options) error {
+		o.feminine = true
+		return nil
+	}
+}
+
+// WithOrdinal writes the ordinal instead of the cardinal number:
+// "vigésimo primeiro" or "milésimo".
+func WithOrdinal() Option {
+	return func(o *options) error {
+		o.ordinal = true
+		return nil
+	}
+}
+
+// WithUnit follows the number with a unit noun, in the singular for one and
+// in the plural otherwise: "um metro quadrado" or "três dólares". Round
+// millions and above take "de", as in "um milhão de dólares". Ordinals
+// always take the singular: "vigésimo andar".
+func WithUnit(singular, plural string) Option {
+	return func(o *options) error {
+		if singular == "" || plural == "" {
+			return ErrInvalidUnit
+		}
+
+		o.singular = singular
+		o.plural = plural
+		return nil
+	}
+}
+
+// WithPercent follows the number with "por cento".
+func WithPercent() Option {
+	return func(o *options) error {
+		o.percent = true
+		return nil
+	}
+}
+
+// Format writes an integer out in Portuguese, by default as a masculine
+// cardinal: 1523 is "mil, quinhentos e vinte e três" and -2 is "menos dois".
+// Returns ErrInvalidOrdinal for the ordinal of a number below 1, or the
+// error of an invalid option.
+func Format(n int64, opts ...Option) (string, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
+	if o.ordinal {
+		if n < 1 {
+			return "", ErrInvalidOrdinal
+		}
+		return o.withUnit(numtext.Ordinal(uint64(n), o.feminine), false), nil
+	}
+
+	abs := uint64(n)
+	if n < 0 {
+		abs = -abs
+	}
+
+	text := o.withUnit(numtext.Cardinal(abs, o.feminine), abs != 1)
+	if n < 0 {
+		text = minusWord + " " + text
+	}
+
+	return text, nil
+}
+
+// FormatDecimal writes a decimal number, given as digits with an optional
+// minus sign and a comma or a dot before the decimals, out in Portuguese with
+// "vírgula": "15,5" is "quinze vírgula cinco" and "2,05" is "dois vírgula
+// zero cinco". Decimals are read as written, so "1,50" is "um vírgula
+// cinquenta". Units take the singular when the integer part is one, as in
+// "um vírgula cinco metro".
+// Returns ErrInvalidNumber for malformed or too large numbers,
+// ErrInvalidOrdinal for the ordinal of a number with decimals, or the error
+// of an invalid option.
+func FormatDecimal(value string, opts ...Option) (string, error) {
+	unsigned, negative := strings.CutPrefix(strings.TrimSpace(value), "-")
+
+	separator := strings.IndexAny(unsigned, ",.")
+	if separator < 0 {
+		n, err := strconv.ParseInt(unsigned, 10, 64)
+		if err != nil || !isDigits(unsigned) {
+			return "", ErrInvalidNumber
+		}
+		if negative {
+			n = -n
+		}
+		return Format(n, opts...)
+	}
+
+	integer, decimals := unsigned[:separator], unsigned[separator+1:]
+	if !isDigits(integer) || !isDigits(decimals) {
+		return "", ErrInvalidNumber
+	}
+
+	o, err := newOptions(opts)
+	if err != nil {
+		return "", err
+	}
+	if o.ordinal {
+		return "", ErrInvalidOrdinal
+	}
+
+	integerValue, err := strconv.ParseUint(integer, 10, 64)
+	if err != nil {
+		return "", ErrInvalidNumber
+	}
+
+	// Leading zeros of the decimals are read one by one
+	significant := strings.TrimLeft(decimals, "0")
+	words := strings.Repeat(" zero", len(decimals)-len(significant))
+	if significant != "" {
+		decimalValue, err := strconv.ParseUint(significant, 10, 64)
+		if err != nil {
+			return "", ErrInvalidNumber
+		}
+		words += " " + numtext.Cardinal(decimalValue, o.feminine)
+	}
+
+	text := o.withUnit(numtext.Cardinal(integerValue, o.feminine)+" "+decimalWord+words, integerValue != 1)
+	if negative {
+		text = minusWord + " " + text
+	}
+
+	return text, nil
+}
+
+// Parse reads an integer written out in Portuguese, the reverse of Format:
+// "mil duzentos e trinta e quatro" is 1234. It accepts any case, accents or
+// no accents, commas, the feminine, variant spellings such as "catorze",
+// ordinals such as "vigésimo primeiro", "meio" after a scale, as in "um
+// milhão e meio", and a leading "menos".
+// Returns ErrUnknownWord, wrapped with the word, for words that are not
+// numbers, ErrInvalidNumber for words in an impossible order, such as
+// "vinte trinta", or for "menos zero", or ErrOutOfRange if the number does
+// not fit in an int64.
+func Parse(text string) (int64, error) {
+	return numtext.Parse(text)
+}
+
+// Words splits a text in lowercase words without accents, dropping
+// punctuation.
+func Words(text string) []string {
+	return numtext.Words(text)
+}
+
+func newOptions(opts []Option) (*options, error) {
+	o := &options{}
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, err
+		}
+	}
+
+	return o, nil
+}
+
+// withUnit appends the unit noun, or "por cento", to the number
+func (o *options) withUnit(text string, plural bool) string {
+	if o.percent {
+		text += " " + percentWord
+	}
+	if o.singular == "" {
+		return text
+	}
+
+	unit := o.singular
+	if plural {
+		unit = o.plural
+	}
+
+	connector := ""
+	if strings.HasSuffix(text, "lhão") || strings.HasSuffix(text, "lhões") {
+		connector = "de "
+	}
+
+	return text + " " + connector + unit
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
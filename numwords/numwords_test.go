@@ -0,0 +1,139 @@
+package numwords_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brazilian-utils/go/numwords"
+)
+
+var formatTests = []struct {
+	input    int64
+	options  []numwords.Option
+	expected string
+}{
+	{0, nil, "zero"},
+	{1, nil, "um"},
+	{21, nil, "vinte e um"},
+	{100, nil, "cem"},
+	{101, nil, "cento e um"},
+	{1523, nil, "mil, quinhentos e vinte e três"},
+	{1500000, nil, "um milhão e quinhentos mil"},
+	{-2, nil, "menos dois"},
+	{math.MaxInt64, nil, "nove quintilhões, duzentos e vinte e três quatrilhões, trezentos e setenta e dois trilhões, trinta e seis bilhões, oitocentos e cinquenta e quatro milhões, setecentos e setenta e cinco mil, oitocentos e sete"},
+
+	// Feminine
+	{1, []numwords.Option{numwords.WithFeminine()}, "uma"},
+	{2, []numwords.Option{numwords.WithFeminine()}, "duas"},
+	{201, []numwords.Option{numwords.WithFeminine(), numwords.WithUnit("parcela", "parcelas")}, "duzentas e uma parcelas"},
+	{1, []numwords.Option{numwords.WithFeminine(), numwords.WithUnit("pessoa", "pessoas")}, "uma pessoa"},
+	{100, []numwords.Option{numwords.WithFeminine()}, "cem"},
+	{132, []numwords.Option{numwords.WithFeminine()}, "cento e trinta e duas"},
+	{2_200, []numwords.Option{numwords.WithFeminine()}, "duas mil e duzentas"},
+	{2_000_000, []numwords.Option{numwords.WithFeminine(), numwords.WithUnit("parcela", "parcelas")}, "dois milhões de parcelas"},
+
+	// Ordinals
+	{1, []numwords.Option{numwords.WithOrdinal()}, "primeiro"},
+	{11, []numwords.Option{numwords.WithOrdinal()}, "décimo primeiro"},
+	{21, []numwords.Option{numwords.WithOrdinal()}, "vigésimo primeiro"},
+	{21, []numwords.Option{numwords.WithOrdinal(), numwords.WithFeminine()}, "vigésima primeira"},
+	{100, []numwords.Option{numwords.WithOrdinal()}, "centésimo"},
+	{345, []numwords.Option{numwords.WithOrdinal()}, "trecentésimo quadragésimo quinto"},
+	{1000, []numwords.Option{numwords.WithOrdinal()}, "milésimo"},
+	{1234, []numwords.Option{numwords.WithOrdinal()}, "milésimo ducentésimo trigésimo quarto"},
+	{2000, []numwords.Option{numwords.WithOrdinal()}, "dois milésimo"},
+	{1_000_000, []numwords.Option{numwords.WithOrdinal(), numwords.WithFeminine()}, "milionésima"},
+	{20, []numwords.Option{numwords.WithOrdinal(), numwords.WithUnit("andar", "andares")}, "vigésimo andar"},
+
+	// Units and percentages
+	{3, []numwords.Option{numwords.WithUnit("dólar", "dólares")}, "três dólares"},
+	{1, []numwords.Option{numwords.WithUnit("metro quadrado", "metros quadrados")}, "um metro quadrado"},
+	{0, []numwords.Option{numwords.WithUnit("metro", "metros")}, "zero metros"},
+	{1_000_000, []numwords.Option{numwords.WithUnit("dólar", "dólares")}, "um milhão de dólares"},
+	{1_500_000, []numwords.Option{numwords.WithUnit("dólar", "dólares")}, "um milhão e quinhentos mil dólares"},
+	{-1, []numwords.Option{numwords.WithUnit("grau", "graus")}, "menos um grau"},
+	{15, []numwords.Option{numwords.WithPercent()}, "quinze por cento"},
+	{1_000_000, []numwords.Option{numwords.WithPercent()}, "um milhão por cento"},
+}
+
+func TestFormat(t *testing.T) {
+	for _, table := range formatTests {
+		res, err := numwords.Format(table.input, table.options...)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.expected, res, err)
+		}
+	}
+}
+
+var formatErrorTests = []struct {
+	input    int64
+	options  []numwords.Option
+	expected error
+}{
+	{0, []numwords.Option{numwords.WithOrdinal()}, numwords.ErrInvalidOrdinal},
+	{-1, []numwords.Option{numwords.WithOrdinal()}, numwords.ErrInvalidOrdinal},
+	{1, []numwords.Option{numwords.WithUnit("metro", "")}, numwords.ErrInvalidUnit},
+}
+
+func TestFormatErrors(t *testing.T) {
+	for _, table := range formatErrorTests {
+		if _, err := numwords.Format(table.input, table.options...); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+var formatDecimalTests = []struct {
+	input    string
+	options  []numwords.Option
+	expected string
+}{
+	{"15,5", nil, "quinze vírgula cinco"},
+	{"15.5", nil, "quinze vírgula cinco"},
+	{"2,05", nil, "dois vírgula zero cinco"},
+	{"0,5", nil, "zero vírgula cinco"},
+	{"1,50", nil, "um vírgula cinquenta"},
+	{"3,0", nil, "três vírgula zero"},
+	{"-0,25", nil, "menos zero vírgula vinte e cinco"},
+	{"42", nil, "quarenta e dois"},
+	{"-42", nil, "menos quarenta e dois"},
+	{"15,5", []numwords.Option{numwords.WithPercent()}, "quinze vírgula cinco por cento"},
+	{"1,2", []numwords.Option{numwords.WithFeminine(), numwords.WithUnit("tonelada", "toneladas")}, "uma vírgula duas tonelada"},
+	{"1,5", []numwords.Option{numwords.WithUnit("metro", "metros")}, "um vírgula cinco metro"},
+	{"2,5", []numwords.Option{numwords.WithUnit("metro", "metros")}, "dois vírgula cinco metros"},
+	{"21", []numwords.Option{numwords.WithOrdinal()}, "vigésimo primeiro"},
+}
+
+func TestFormatDecimal(t *testing.T) {
+	for _, table := range formatDecimalTests {
+		res, err := numwords.FormatDecimal(table.input, table.options...)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.expected, res, err)
+		}
+	}
+}
+
+var formatDecimalErrorTests = []struct {
+	input    string
+	options  []numwords.Option
+	expected error
+}{
+	{"", nil, numwords.ErrInvalidNumber},
+	{"abc", nil, numwords.ErrInvalidNumber},
+	{"1,", nil, numwords.ErrInvalidNumber},
+	{",5", nil, numwords.ErrInvalidNumber},
+	{"1.234,5", nil, numwords.ErrInvalidNumber},
+	{"+1", nil, numwords.ErrInvalidNumber},
+	{"99999999999999999999", nil, numwords.ErrInvalidNumber},
+	{"1,5", []numwords.Option{numwords.WithOrdinal()}, numwords.ErrInvalidOrdinal},
+	{"1,5", []numwords.Option{numwords.WithUnit("", "metros")}, numwords.ErrInvalidUnit},
+}
+
+func TestFormatDecimalErrors(t *testing.T) {
+	for _, table := range formatDecimalErrorTests {
+		if _, err := numwords.FormatDecimal(table.input, table.options...); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %q \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package numwords_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brazilian-utils/go/numwords"
+)
+
+var parseTests = []struct {
+	input    string
+	expected int64
+}{
+	{"zero", 0},
+	{"um", 1},
+	{"mil duzentos e trinta e quatro", 1234},
+	{"mil, duzentos e trinta e quatro", 1234},
+	{"Mil, quinhentos e vinte e três", 1523},
+	{"mil quinhentos e vinte e tres", 1523},
+	{"catorze", 14},
+	{"quatorze", 14},
+	{"cem", 100},
+	{"cento e um", 101},
+	{"duzentas e uma", 201},
+	{"duas mil e duzentas", 2200},
+	{"vinte e um mil", 21000},
+	{"cento e vinte e três mil, quatrocentos e cinquenta e seis", 123456},
+	{"um milhão e meio", 1500000},
+	{"dois bilhões e meio", 2500000000},
+	{"mil e meio", 1500},
+	{"um milhão e quinhentos mil", 1500000},
+	{"MENOS DOIS", -2},
+	{"vigésimo primeiro", 21},
+	{"vigesima primeira", 21},
+	{"milésimo", 1000},
+	{"milésimo ducentésimo trigésimo quarto", 1234},
+	{"dois milésimo", 2000},
+	{"trecentésimo quadragésimo quinto", 345},
+	{"nove quintilhões, duzentos e vinte e três quatrilhões, trezentos e setenta e dois trilhões, trinta e seis bilhões, oitocentos e cinquenta e quatro milhões, setecentos e setenta e cinco mil, oitocentos e sete", math.MaxInt64},
+}
+
+func TestParse(t *testing.T) {
+	for _, table := range parseTests {
+		res, err := numwords.Parse(table.input)
+		if err != nil || res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.expected, res, err)
+		}
+	}
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	for _, n := range []int64{math.MinInt64, -1, 0, 1, 19, 99, 100, 101, 999, 1001, 1100, 12345, 1000000, 2000001, 987654321, 1000000000000, math.MaxInt64} {
+		for _, options := range [][]numwords.Option{nil, {numwords.WithFeminine()}, {numwords.WithOrdinal()}} {
+			text, err := numwords.Format(n, options...)
+			if err != nil {
+				// Only positive numbers have ordinals
+				continue
+			}
+			if res, err := numwords.Parse(text); err != nil || res != n {
+				t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", text, n, res, err)
+			}
+		}
+	}
+}
+
+var parseErrorTests = []struct {
+	input    string
+	expected error
+}{
+	{"", numwords.ErrInvalidNumber},
+	{"e", numwords.ErrInvalidNumber},
+	{"vinte trinta", numwords.ErrInvalidNumber},
+	{"vinte quinze", numwords.ErrInvalidNumber},
+	{"cinco vinte", numwords.ErrInvalidNumber},
+	{"cem e um", numwords.ErrInvalidNumber},
+	{"mil mil", numwords.ErrInvalidNumber},
+	{"mil milhões", numwords.ErrInvalidNumber},
+	{"zero e um", numwords.ErrInvalidNumber},
+	{"dois e meio", numwords.ErrInvalidNumber},
+	{"um milhão e meio e dois", numwords.ErrInvalidNumber},
+	{"dois menos um", numwords.ErrInvalidNumber},
+	{"menos zero", numwords.ErrInvalidNumber},
+	{"vinte e hum", numwords.ErrUnknownWord},
+	{"dez quintilhões", numwords.ErrOutOfRange},
+	{"nove quintilhões, duzentos e vinte e três quatrilhões, trezentos e setenta e dois trilhões, trinta e seis bilhões, oitocentos e cinquenta e quatro milhões, setecentos e setenta e cinco mil, oitocentos e oito", numwords.ErrOutOfRange},
+	{"menos nove quintilhões, duzentos e vinte e três quatrilhões, trezentos e setenta e dois trilhões, trinta e seis bilhões, oitocentos e cinquenta e quatro milhões, setecentos e setenta e cinco mil, oitocentos e nove", numwords.ErrOutOfRange},
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, table := range parseErrorTests {
+		if _, err := numwords.Parse(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %q \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
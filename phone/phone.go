@@ -2,9 +2,12 @@ package phone
 
 import (
 	"fmt"
+	"log/slog"
 	"math/rand"
 	"regexp"
 	"strings"
+
+	"github.com/brazilian-utils/go/helpers"
 )
 
 var mobileRegex = regexp.MustCompile(`^[1-9][1-9]9\d{8}$`)
@@ -24,6 +27,18 @@ func IsValid(phoneNumber string, phoneType string) bool {
 	}
 }
 
+// Validator implements the brutils.Validator interface for phone numbers
+// using IsValid. Type is the phoneType passed to IsValid: "mobile",
+// "landline", or "" to accept either.
+type Validator struct {
+	Type string
+}
+
+// Validate reports whether input is valid.
+func (v Validator) Validate(input string) bool {
+	return IsValid(input, v.Type)
+}
+
 // Format formats a phone number into the standard display pattern.
 // Mobile: "(DD)NNNNN-NNNN", Landline: "(DD)NNNN-NNNN".
 // Returns empty string if invalid.
@@ -38,6 +53,89 @@ func Format(phoneNumber string) string {
 	return fmt.Sprintf("(%s)%s-%s", ddd, number[:len(number)-4], number[len(number)-4:])
 }
 
+// Formatter implements the brutils.Formatter interface for phone numbers using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
+// FormatPartial formats the digits present in a phone number being typed,
+// following the pattern Format produces for the complete number: a third
+// digit 9 selects the mobile mask "(DD)NNNNN-NNNN", anything else the
+// landline mask "(DD)NNNN-NNNN". The parenthesis closes as soon as the DDD
+// is complete. Extra digits are truncated.
+func FormatPartial(phoneNumber string) string {
+	digits := helpers.OnlyNumbers(phoneNumber)
+
+	size := 10
+	if len(digits) > 2 && digits[2] == '9' {
+		size = 11
+	}
+	if len(digits) > size {
+		digits = digits[:size]
+	}
+
+	formatted := format(digits, size-4)
+	if len(digits) == 2 {
+		formatted += ")"
+	}
+
+	return formatted
+}
+
+// Mask returns the formatted phone number with all but the DDD and the
+// last four digits hidden, e.g. "(11)*****-9275". Returns an empty string
+// if the phone number is invalid.
+func Mask(phoneNumber string) string {
+	digits := helpers.OnlyNumbers(phoneNumber)
+	if !IsValid(digits, "") {
+		return ""
+	}
+
+	masked := helpers.Mask(digits, [2]int{0, 2}, [2]int{len(digits) - 4, len(digits)})
+	return format(masked, len(digits)-4)
+}
+
+// MaskRange returns the formatted phone number with only the digits at
+// indexes [start, end) visible. Returns an empty string if the phone number
+// is invalid.
+func MaskRange(phoneNumber string, start int, end int) string {
+	digits := helpers.OnlyNumbers(phoneNumber)
+	if !IsValid(digits, "") {
+		return ""
+	}
+
+	return format(helpers.Mask(digits, [2]int{start, end}), len(digits)-4)
+}
+
+// Masked is a phone number that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
+
+// format inserts the DDD parentheses and the hyphen before hyphenIndex
+func format(digits string, hyphenIndex int) string {
+	var buf strings.Builder
+	for index, character := range digits {
+		switch index {
+		case 0:
+			buf.WriteByte('(')
+		case 2:
+			buf.WriteByte(')')
+		case hyphenIndex:
+			buf.WriteByte('-')
+		}
+		buf.WriteRune(character)
+	}
+
+	return buf.String()
+}
+
 // RemoveSymbols removes common symbols from a phone number string: ()+-  and spaces.
 func RemoveSymbols(phoneNumber string) string {
 	r := strings.NewReplacer("(", "", ")", "", "-", "", "+", "", " ", "")
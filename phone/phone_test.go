@@ -109,3 +109,56 @@ func TestGenerateRandom(t *testing.T) {
 		t.Errorf("Generated invalid phone number: %v", generated)
 	}
 }
+
+var formatPartialTests = []struct {
+	input    string
+	expected string
+}{
+	{"", ""},
+	{"1", "(1"},
+	{"11", "(11)"},
+	{"(11", "(11)"},
+	{"119", "(11)9"},
+	{"1199402", "(11)99402"},
+	{"119940292", "(11)99402-92"},
+	{"11994029275", "(11)99402-9275"},
+	{"119940292751", "(11)99402-9275"},
+	{"163501", "(16)3501"},
+	{"1635014", "(16)3501-4"},
+	{"1635014415", "(16)3501-4415"},
+	{"16350144150", "(16)3501-4415"},
+	{"(11) 99402-9275", "(11)99402-9275"},
+}
+
+func TestFormatPartial(t *testing.T) {
+	for _, table := range formatPartialTests {
+		if res := phone.FormatPartial(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"11994029275", "(11)*****-9275"},
+	{"(11)99402-9275", "(11)*****-9275"},
+	{"1635014415", "(16)****-4415"},
+	{"333333", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := phone.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+
+	if res := phone.MaskRange("11994029275", 0, 2); res != "(11)*****-****" {
+		t.Errorf("Expected (11)*****-****, got %v", res)
+	}
+	if res := phone.Masked("11994029275").LogValue().String(); res != "(11)*****-9275" {
+		t.Errorf("Expected masked phone, got %v", res)
+	}
+}
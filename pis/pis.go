@@ -1,25 +1,63 @@
 package pis
 
 import (
+	"errors"
 	"fmt"
+	"log/slog"
 	"math/rand"
+	"strings"
 
 	"github.com/brazilian-utils/go/helpers"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength     = errors.New("pis: invalid length")
+	ErrInvalidCharacters = errors.New("pis: invalid characters")
+	ErrInvalidCheckDigit = errors.New("pis: invalid check digit")
+)
+
 const pisSize = 11
 
 var weights = []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
 
+// Positions where formatting symbols are inserted: NNN.NNNNN.NN-N
+var dotIndexes = []int{3, 8}
+var hyphenIndexes = []int{10}
+
+// Digits of a PIS left visible by Mask
+var defaultVisibleRange = [2]int{3, 8}
+
 // IsValid checks if a PIS (Programa de Integração Social) number is valid.
 func IsValid(pis string) bool {
+	return Validate(pis) == nil
+}
+
+// Validate validates a PIS number and returns the reason why it is invalid,
+// or nil if it is valid. The PIS must contain only digits.
+func Validate(pis string) error {
 	cleaned := helpers.OnlyNumbers(pis)
-	if len(cleaned) != pisSize || cleaned != pis {
-		return false
+	if cleaned != pis {
+		return ErrInvalidCharacters
+	}
+	if len(cleaned) != pisSize {
+		return ErrInvalidLength
 	}
 
 	expected := checksum(cleaned[:10])
-	return int(cleaned[10]-'0') == expected
+	if int(cleaned[10]-'0') != expected {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// Validator implements the brutils.Validator interface for PIS numbers using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
 }
 
 // Format formats a valid PIS into "NNN.NNNNN.NN-N".
@@ -31,6 +69,68 @@ func Format(pis string) string {
 	return fmt.Sprintf("%s.%s.%s-%s", pis[:3], pis[3:8], pis[8:10], pis[10:11])
 }
 
+// Formatter implements the brutils.Formatter interface for PIS numbers using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
+// FormatPartial formats the digits present in a PIS being typed, e.g.
+// "123.4567" for "1234567". Input longer than 11 digits is truncated.
+func FormatPartial(pis string) string {
+	cleaned := helpers.OnlyNumbers(pis)
+	if len(cleaned) > pisSize {
+		cleaned = cleaned[:pisSize]
+	}
+
+	return format(cleaned)
+}
+
+// Mask returns the formatted PIS with all but its middle five digits hidden,
+// e.g. "***.45678.**-*". Returns an empty string if the input does not
+// contain exactly 11 digits.
+func Mask(pis string) string {
+	return MaskRange(pis, defaultVisibleRange[0], defaultVisibleRange[1])
+}
+
+// MaskRange returns the formatted PIS with only the digits at indexes
+// [start, end) visible. Returns an empty string if the input does not
+// contain exactly 11 digits.
+func MaskRange(pis string, start int, end int) string {
+	cleaned := helpers.OnlyNumbers(pis)
+	if len(cleaned) != pisSize {
+		return ""
+	}
+
+	return format(helpers.Mask(cleaned, [2]int{start, end}))
+}
+
+// Masked is a PIS that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
+
+// format inserts the formatting symbols into up to 11 PIS characters
+func format(cleaned string) string {
+	var buf strings.Builder
+	for index, character := range cleaned {
+		if helpers.ContainsInt(dotIndexes, index) {
+			buf.WriteByte('.')
+		}
+		if helpers.ContainsInt(hyphenIndexes, index) {
+			buf.WriteByte('-')
+		}
+		buf.WriteRune(character)
+	}
+
+	return buf.String()
+}
+
 // Generate generates a random valid PIS number.
 func Generate() string {
 	base := fmt.Sprintf("%010d", rand.Intn(10000000000))
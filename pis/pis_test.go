@@ -1,6 +1,7 @@
 package pis_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/pis"
@@ -59,3 +60,66 @@ func TestGenerate(t *testing.T) {
 		}
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"12345678900", nil},
+	{"1234567890", pis.ErrInvalidLength},
+	{"123.45678.90-0", pis.ErrInvalidCharacters},
+	{"12345678901", pis.ErrInvalidCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := pis.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+var formatPartialTests = []struct {
+	input    string
+	expected string
+}{
+	{"", ""},
+	{"123", "123"},
+	{"1234", "123.4"},
+	{"1234567", "123.4567"},
+	{"123456789", "123.45678.9"},
+	{"12345678900", "123.45678.90-0"},
+	{"123456789001", "123.45678.90-0"},
+}
+
+func TestFormatPartial(t *testing.T) {
+	for _, table := range formatPartialTests {
+		if res := pis.FormatPartial(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"12345678900", "***.45678.**-*"},
+	{"123.45678.90-0", "***.45678.**-*"},
+	{"1234567890", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := pis.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+
+	if res := pis.MaskRange("12345678900", 8, 11); res != "***.*****.90-0" {
+		t.Errorf("Expected ***.*****.90-0, got %v", res)
+	}
+	if res := pis.Masked("12345678900").LogValue().String(); res != "***.45678.**-*" {
+		t.Errorf("Expected masked PIS, got %v", res)
+	}
+}
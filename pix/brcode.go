@@ -0,0 +1,380 @@
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/brazilian-utils/go/currency"
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Errors returned by Encode and Decode. They can be compared with errors.Is.
+var (
+	ErrInvalidPayload      = errors.New("pix: invalid BR Code payload")
+	ErrInvalidCRC          = errors.New("pix: invalid BR Code CRC")
+	ErrInvalidGUI          = errors.New("pix: invalid BR Code GUI")
+	ErrMissingKeyOrURL     = errors.New("pix: BR Code needs either a key or a URL")
+	ErrInvalidAmount       = errors.New("pix: invalid amount")
+	ErrInvalidMerchantName = errors.New("pix: invalid merchant name")
+	ErrInvalidMerchantCity = errors.New("pix: invalid merchant city")
+	ErrInvalidCategoryCode = errors.New("pix: invalid merchant category code")
+	ErrInvalidTxID         = errors.New("pix: invalid txid")
+	ErrInvalidDescription  = errors.New("pix: invalid description")
+	ErrInvalidURL          = errors.New("pix: invalid URL")
+)
+
+// IDs of the EMV MPM fields used by BR Codes
+const (
+	idPayloadFormatIndicator  = "00"
+	idPointOfInitiationMethod = "01"
+	idMerchantAccountInfo     = "26"
+	idMerchantCategoryCode    = "52"
+	idTransactionCurrency     = "53"
+	idTransactionAmount       = "54"
+	idCountryCode             = "58"
+	idMerchantName            = "59"
+	idMerchantCity            = "60"
+	idAdditionalDataField     = "62"
+	idCRC16                   = "63"
+)
+
+// IDs of the fields nested in the merchant account information (26) and the
+// additional data field (62)
+const (
+	idGUI         = "00"
+	idKey         = "01"
+	idDescription = "02"
+	idURL         = "25"
+	idTxID        = "05"
+)
+
+// Fixed values of a PIX BR Code
+const (
+	payloadFormatIndicator   = "01"
+	dynamicPointOfInitiation = "12"
+	gui                      = "br.gov.bcb.pix"
+	defaultMerchantCategory  = "0000"
+	realCurrencyCode         = "986"
+	countryCode              = "BR"
+	emptyTxID                = "***"
+	urlScheme                = "https://"
+)
+
+// Length limits of the BR Code fields
+const (
+	maxFieldLength        = 99
+	maxAmountLength       = 13
+	maxMerchantNameLength = 25
+	maxMerchantCityLength = 15
+	maxTxIDLength         = 25
+)
+
+// Every field starts with its 2-digit ID and 2-digit length, and the payload
+// ends with the CRC16 field, whose value has 4 hexadecimal digits
+const (
+	fieldHeaderLength = 4
+	crcLength         = 4
+	crcFieldHeader    = idCRC16 + "04"
+)
+
+// Letters and digits accepted in the txid
+const txIDAllowedCharacters = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// BRCode holds the data of a PIX BR Code, the EMV QR Code payload shared as
+// "pix copia e cola". A static BR Code carries the receiver's key; a dynamic
+// one carries the URL of the payment location on the receiver's PSP.
+type BRCode struct {
+	// Key is the receiver's PIX key, in static BR Codes.
+	Key string
+	// Description is an optional message to the payer, in static BR Codes.
+	Description string
+	// URL is the payment location without "https://", in dynamic BR Codes.
+	URL string
+	// MerchantCategoryCode is the ISO 18245 category; "0000" if empty.
+	MerchantCategoryCode string
+	// Amount is the amount to pay; 0 lets the payer choose it.
+	Amount currency.Amount
+	// MerchantName is the receiver's name, up to 25 characters.
+	MerchantName string
+	// MerchantCity is the receiver's city, up to 15 characters.
+	MerchantCity string
+	// TxID identifies the transaction, up to 25 letters and digits; "***"
+	// when empty, as in dynamic BR Codes, whose txid is in the location.
+	TxID string
+}
+
+// IsDynamic reports whether the BR Code points to a payment location instead
+// of carrying the key.
+func (b *BRCode) IsDynamic() bool {
+	return b.URL != ""
+}
+
+// Encode builds the BR Code payload, ending with its CRC16 checksum. The key
+// is normalized with NormalizeKey, and accents are removed from the
+// description, the merchant name and city. Other characters outside ASCII
+// are rejected, as EMV field lengths count characters.
+// Returns the error reported by ValidateKey for an invalid key, or the error
+// of the first invalid field.
+func (b *BRCode) Encode() (string, error) {
+	merchantAccount, err := b.encodeMerchantAccount()
+	if err != nil {
+		return "", err
+	}
+
+	name := removeAccents(strings.TrimSpace(b.MerchantName))
+	if name == "" || len(name) > maxMerchantNameLength || !isASCII(name) {
+		return "", ErrInvalidMerchantName
+	}
+	city := removeAccents(strings.TrimSpace(b.MerchantCity))
+	if city == "" || len(city) > maxMerchantCityLength || !isASCII(city) {
+		return "", ErrInvalidMerchantCity
+	}
+
+	txID := b.TxID
+	if txID == "" {
+		txID = emptyTxID
+	} else if txID != emptyTxID && (len(txID) > maxTxIDLength || !helpers.ContainsOnly(txID, txIDAllowedCharacters)) {
+		return "", ErrInvalidTxID
+	}
+
+	amount, _ := b.Amount.MarshalText()
+	if b.Amount < 0 || len(amount) > maxAmountLength {
+		return "", ErrInvalidAmount
+	}
+
+	category := b.MerchantCategoryCode
+	if category == "" {
+		category = defaultMerchantCategory
+	}
+	if !isValidCategoryCode(category) {
+		return "", ErrInvalidCategoryCode
+	}
+
+	var payload strings.Builder
+	payload.WriteString(field(idPayloadFormatIndicator, payloadFormatIndicator))
+	if b.IsDynamic() {
+		payload.WriteString(field(idPointOfInitiationMethod, dynamicPointOfInitiation))
+	}
+	payload.WriteString(field(idMerchantAccountInfo, merchantAccount))
+	payload.WriteString(field(idMerchantCategoryCode, category))
+	payload.WriteString(field(idTransactionCurrency, realCurrencyCode))
+	if b.Amount > 0 {
+		payload.WriteString(field(idTransactionAmount, string(amount)))
+	}
+	payload.WriteString(field(idCountryCode, countryCode))
+	payload.WriteString(field(idMerchantName, name))
+	payload.WriteString(field(idMerchantCity, city))
+	payload.WriteString(field(idAdditionalDataField, field(idTxID, txID)))
+	payload.WriteString(crcFieldHeader)
+
+	return payload.String() + crc16(payload.String()), nil
+}
+
+func (b *BRCode) encodeMerchantAccount() (string, error) {
+	var account strings.Builder
+	account.WriteString(field(idGUI, gui))
+
+	switch {
+	case b.IsDynamic():
+		account.WriteString(field(idURL, strings.TrimPrefix(b.URL, urlScheme)))
+	case b.Key != "":
+		key, err := NormalizeKey(b.Key)
+		if err != nil {
+			return "", err
+		}
+		account.WriteString(field(idKey, key))
+		if b.Description != "" {
+			description := removeAccents(b.Description)
+			if !isASCII(description) {
+				return "", ErrInvalidDescription
+			}
+			account.WriteString(field(idDescription, description))
+		}
+	default:
+		return "", ErrMissingKeyOrURL
+	}
+
+	if account.Len() > maxFieldLength {
+		if b.IsDynamic() {
+			return "", ErrInvalidURL
+		}
+		return "", ErrInvalidDescription
+	}
+
+	return account.String(), nil
+}
+
+// Decode parses a BR Code payload, verifying its structure, GUI and CRC16
+// checksum. The key is returned as found in the payload.
+// Returns ErrInvalidCRC if the checksum does not match, ErrInvalidGUI if the
+// payload is not a PIX BR Code, ErrInvalidCategoryCode if the merchant
+// category code is not 4 digits, or ErrInvalidPayload if it is malformed.
+func Decode(payload string) (*BRCode, error) {
+	payload = strings.TrimSpace(payload)
+	if len(payload) < len(crcFieldHeader)+crcLength {
+		return nil, ErrInvalidPayload
+	}
+
+	crcStart := len(payload) - crcLength
+	if payload[crcStart-len(crcFieldHeader):crcStart] != crcFieldHeader {
+		return nil, ErrInvalidPayload
+	}
+	if !strings.EqualFold(payload[crcStart:], crc16(payload[:crcStart])) {
+		return nil, ErrInvalidCRC
+	}
+
+	fields, err := parseFields(payload)
+	if err != nil {
+		return nil, err
+	}
+	if fields[idPayloadFormatIndicator] != payloadFormatIndicator ||
+		fields[idTransactionCurrency] != realCurrencyCode ||
+		fields[idMerchantName] == "" || fields[idMerchantCity] == "" {
+		return nil, ErrInvalidPayload
+	}
+
+	account, err := parseFields(fields[idMerchantAccountInfo])
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(account[idGUI], gui) {
+		return nil, ErrInvalidGUI
+	}
+
+	additionalData, err := parseFields(fields[idAdditionalDataField])
+	if err != nil {
+		return nil, err
+	}
+
+	if !isValidCategoryCode(fields[idMerchantCategoryCode]) {
+		return nil, ErrInvalidCategoryCode
+	}
+
+	amount, err := parseAmount(fields[idTransactionAmount])
+	if err != nil {
+		return nil, err
+	}
+
+	brCode := &BRCode{
+		Key:                  account[idKey],
+		Description:          account[idDescription],
+		URL:                  account[idURL],
+		MerchantCategoryCode: fields[idMerchantCategoryCode],
+		Amount:               amount,
+		MerchantName:         fields[idMerchantName],
+		MerchantCity:         fields[idMerchantCity],
+		TxID:                 additionalData[idTxID],
+	}
+	if brCode.Key == "" && brCode.URL == "" {
+		return nil, ErrMissingKeyOrURL
+	}
+
+	return brCode, nil
+}
+
+// field encodes an EMV TLV field: the 2-digit ID, the 2-digit length and
+// the value
+func field(id string, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// parseFields splits a sequence of EMV TLV fields by ID
+func parseFields(payload string) (map[string]string, error) {
+	fields := map[string]string{}
+	for index := 0; index < len(payload); {
+		if index+fieldHeaderLength > len(payload) {
+			return nil, ErrInvalidPayload
+		}
+
+		id := payload[index : index+2]
+		length, err := strconv.Atoi(payload[index+2 : index+fieldHeaderLength])
+		if err != nil || length < 0 {
+			return nil, ErrInvalidPayload
+		}
+
+		index += fieldHeaderLength
+		if index+length > len(payload) {
+			return nil, ErrInvalidPayload
+		}
+
+		fields[id] = payload[index : index+length]
+		index += length
+	}
+
+	return fields, nil
+}
+
+// parseAmount parses the BR Code amount, which has no sign and up to two
+// decimal places. An empty amount is 0.
+func parseAmount(amount string) (currency.Amount, error) {
+	reais, cents, hasCents := strings.Cut(amount, ".")
+	if amount != "" && (reais == "" || !helpers.ContainsOnly(reais, "0123456789") || len(cents) > 2 || !helpers.ContainsOnly(cents, "0123456789") || (hasCents && cents == "")) {
+		return 0, ErrInvalidAmount
+	}
+
+	var value currency.Amount
+	if err := value.UnmarshalText([]byte(amount)); err != nil {
+		return 0, ErrInvalidAmount
+	}
+
+	return value, nil
+}
+
+// crc16 computes the CRC16-CCITT checksum (polynomial 0x1021, initial value
+// 0xFFFF) of the payload, as 4 uppercase hexadecimal digits
+func crc16(payload string) string {
+	crc := uint16(0xFFFF)
+	for index := 0; index < len(payload); index++ {
+		crc ^= uint16(payload[index]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return fmt.Sprintf("%04X", crc)
+}
+
+// Replaces accented letters, which BR Code readers may not support, in the
+// description, merchant name and city
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A",
+	"É", "E", "È", "E", "Ê", "E", "Ë", "E",
+	"Í", "I", "Ì", "I", "Î", "I", "Ï", "I",
+	"Ó", "O", "Ò", "O", "Ô", "O", "Õ", "O", "Ö", "O",
+	"Ú", "U", "Ù", "U", "Û", "U", "Ü", "U",
+	"Ç", "C", "Ñ", "N",
+)
+
+func removeAccents(value string) string {
+	return accentReplacer.Replace(value)
+}
+
+// isASCII reports whether value has only ASCII characters, whose byte
+// length is also their character count
+func isASCII(value string) bool {
+	for index := 0; index < len(value); index++ {
+		if value[index] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// isValidCategoryCode reports whether code is a 4-digit ISO 18245 merchant
+// category code
+func isValidCategoryCode(code string) bool {
+	return len(code) == 4 && helpers.ContainsOnly(code, "0123456789")
+}
@@ -0,0 +1,163 @@
+package pix_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/currency"
+	"github.com/brazilian-utils/go/pix"
+)
+
+// Static BR Code from the Central Bank's BR Code manual
+const manualBRCode = "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+
+const (
+	staticBRCode  = "00020126490014br.gov.bcb.pix0114+55119876543210209Pedido 4252040000530398654071234.505802BR5914Joao Sebastiao6009Sao Paulo62120508PEDIDO4263049FD1"
+	dynamicBRCode = "00020101021226760014br.gov.bcb.pix2554pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca255204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63047EC0"
+	accentBRCode  = "00020126570014br.gov.bcb.pix0114+55119876543210217Pagamento a vista5204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***630490CE"
+)
+
+var encodeTests = []struct {
+	brCode   pix.BRCode
+	expected string
+}{
+	{
+		pix.BRCode{
+			Key:          "(11) 98765-4321",
+			Description:  "Pedido 42",
+			Amount:       currency.FromCentavos(123450),
+			MerchantName: "João Sebastião",
+			MerchantCity: "São Paulo",
+			TxID:         "PEDIDO42",
+		},
+		staticBRCode,
+	},
+	{
+		pix.BRCode{
+			URL:          "https://pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca25",
+			MerchantName: "Fulano de Tal",
+			MerchantCity: "BRASILIA",
+		},
+		dynamicBRCode,
+	},
+	{
+		pix.BRCode{
+			Key:          "+5511987654321",
+			Description:  "Pagamento à vista",
+			MerchantName: "Fulano de Tal",
+			MerchantCity: "BRASILIA",
+		},
+		accentBRCode,
+	},
+}
+
+func TestEncode(t *testing.T) {
+	for _, table := range encodeTests {
+		res, err := table.brCode.Encode()
+		if res != table.expected || err != nil {
+			t.Errorf("Failing for %+v \t Expected: %v | Received: %v (%v)", table.brCode, table.expected, res, err)
+		}
+	}
+}
+
+var decodeTests = []struct {
+	input    string
+	expected pix.BRCode
+}{
+	{
+		manualBRCode,
+		pix.BRCode{
+			Key:                  "123e4567-e12b-12d1-a456-426655440000",
+			MerchantCategoryCode: "0000",
+			MerchantName:         "Fulano de Tal",
+			MerchantCity:         "BRASILIA",
+			TxID:                 "***",
+		},
+	},
+	{
+		staticBRCode,
+		pix.BRCode{
+			Key:                  "+5511987654321",
+			Description:          "Pedido 42",
+			MerchantCategoryCode: "0000",
+			Amount:               currency.FromCentavos(123450),
+			MerchantName:         "Joao Sebastiao",
+			MerchantCity:         "Sao Paulo",
+			TxID:                 "PEDIDO42",
+		},
+	},
+	{
+		dynamicBRCode,
+		pix.BRCode{
+			URL:                  "pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca25",
+			MerchantCategoryCode: "0000",
+			MerchantName:         "Fulano de Tal",
+			MerchantCity:         "BRASILIA",
+			TxID:                 "***",
+		},
+	},
+}
+
+func TestDecode(t *testing.T) {
+	for _, table := range decodeTests {
+		res, err := pix.Decode(table.input)
+		if err != nil {
+			t.Fatalf("Failing for %v \t Unexpected error: %v", table.input, err)
+		}
+		if *res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %+v | Received: %+v", table.input, table.expected, *res)
+		}
+	}
+
+	decoded, _ := pix.Decode(dynamicBRCode)
+	if !decoded.IsDynamic() {
+		t.Errorf("Expected %v to be dynamic", dynamicBRCode)
+	}
+}
+
+var decodeErrorTests = []struct {
+	input    string
+	expected error
+}{
+	{"", pix.ErrInvalidPayload},
+	{"000201", pix.ErrInvalidPayload},
+	{manualBRCode[:len(manualBRCode)-4] + "1D3E", pix.ErrInvalidCRC},
+	{"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3", pix.ErrInvalidPayload},
+	{"00020126580014br.gov.bcb.pax0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***6304AB1F", pix.ErrInvalidGUI},
+	{"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053038405802BR5913Fulano de Tal6008BRASILIA62070503***63040C88", pix.ErrInvalidPayload},
+	{"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204ABCD53039865802BR5913Fulano de Tal6008BRASILIA62070503***630498BA", pix.ErrInvalidCategoryCode},
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, table := range decodeErrorTests {
+		if _, err := pix.Decode(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+var encodeErrorTests = []struct {
+	brCode   pix.BRCode
+	expected error
+}{
+	{pix.BRCode{MerchantName: "Fulano", MerchantCity: "Brasilia"}, pix.ErrMissingKeyOrURL},
+	{pix.BRCode{Key: "fulano@", MerchantName: "Fulano", MerchantCity: "Brasilia"}, pix.ErrInvalidEmail},
+	{pix.BRCode{Key: "fulano@example.com", MerchantCity: "Brasilia"}, pix.ErrInvalidMerchantName},
+	{pix.BRCode{Key: "fulano@example.com", MerchantName: "Fulano", MerchantCity: "Sao Jose dos Campos"}, pix.ErrInvalidMerchantCity},
+	{pix.BRCode{Key: "fulano@example.com", MerchantName: "Fulano 李", MerchantCity: "Brasilia"}, pix.ErrInvalidMerchantName},
+	{pix.BRCode{Key: "fulano@example.com", MerchantName: "Fulano", MerchantCity: "Brasília ✓"}, pix.ErrInvalidMerchantCity},
+	{pix.BRCode{Key: "fulano@example.com", Description: "Pagamento ✓", MerchantName: "Fulano", MerchantCity: "Brasilia"}, pix.ErrInvalidDescription},
+	{pix.BRCode{Key: "fulano@example.com", MerchantCategoryCode: "12345", MerchantName: "Fulano", MerchantCity: "Brasilia"}, pix.ErrInvalidCategoryCode},
+	{pix.BRCode{Key: "fulano@example.com", MerchantCategoryCode: "abc", MerchantName: "Fulano", MerchantCity: "Brasilia"}, pix.ErrInvalidCategoryCode},
+	{pix.BRCode{Key: "fulano@example.com", MerchantName: "Fulano", MerchantCity: "Brasilia", TxID: "pedido-42"}, pix.ErrInvalidTxID},
+	{pix.BRCode{Key: "fulano@example.com", MerchantName: "Fulano", MerchantCity: "Brasilia", Amount: -1}, pix.ErrInvalidAmount},
+	{pix.BRCode{Key: "fulano@example.com", MerchantName: "Fulano", MerchantCity: "Brasilia", Amount: currency.FromCentavos(100000000000000)}, pix.ErrInvalidAmount},
+}
+
+func TestEncodeErrors(t *testing.T) {
+	for _, table := range encodeErrorTests {
+		if _, err := table.brCode.Encode(); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %+v \t Expected: %v | Received: %v", table.brCode, table.expected, err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Package pix validates PIX keys and builds and parses BR Code payloads.
+package pix
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/brazilian-utils/go/cnpj"
+	"github.com/brazilian-utils/go/cpf"
+	"github.com/brazilian-utils/go/email"
+	"github.com/brazilian-utils/go/helpers"
+	"github.com/brazilian-utils/go/phone"
+)
+
+// KeyType is the kind of a PIX key. Its value is the name used by the DICT
+// directory.
+type KeyType string
+
+// Supported PIX key types.
+const (
+	CPF   KeyType = "CPF"
+	CNPJ  KeyType = "CNPJ"
+	Phone KeyType = "PHONE"
+	Email KeyType = "EMAIL"
+	EVP   KeyType = "EVP"
+)
+
+// Errors returned by ValidateKey. They can be compared with errors.Is.
+var (
+	ErrInvalidKey   = errors.New("pix: invalid key")
+	ErrInvalidCPF   = errors.New("pix: invalid CPF key")
+	ErrInvalidCNPJ  = errors.New("pix: invalid CNPJ key")
+	ErrInvalidPhone = errors.New("pix: invalid phone key")
+	ErrInvalidEmail = errors.New("pix: invalid email key")
+	ErrInvalidEVP   = errors.New("pix: invalid random key")
+)
+
+// Country code of Brazilian phone keys
+const brazilCountryCode = "55"
+
+// DICT limits email keys to 77 characters
+const maxEmailLength = 77
+
+// Random keys (EVP) are UUIDs, with or without hyphens
+var evpRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+
+// Formatting symbols accepted in CPF, CNPJ and phone keys
+const documentSymbols = ".-/() "
+
+// IsValidKey checks if a given PIX key is valid
+func IsValidKey(key string) bool {
+	_, err := ValidateKey(key)
+	return err == nil
+}
+
+// ValidateKey classifies a PIX key typed by a user, with or without
+// formatting, and returns the reason why it is invalid, or nil if it is
+// valid. An 11-digit key that is both a valid CPF and a valid phone number
+// without the country code is classified as a CPF, since phone keys are
+// usually typed with "+55" or the DDD in parentheses.
+func ValidateKey(key string) (KeyType, error) {
+	_, keyType, err := normalizeKey(key)
+	return keyType, err
+}
+
+// NormalizeKey returns a PIX key in the format the DICT directory expects:
+// CPFs and CNPJs without formatting, phones as "+55" followed by the DDD and
+// number, emails in lowercase and random keys as lowercase UUIDs with
+// hyphens.
+// Returns the error reported by ValidateKey if the key is invalid.
+func NormalizeKey(key string) (string, error) {
+	normalized, _, err := normalizeKey(key)
+	return normalized, err
+}
+
+// Validator implements the brutils.Validator interface for PIX keys using IsValidKey.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValidKey(input)
+}
+
+// Formatter implements the brutils.Formatter interface for PIX keys using
+// NormalizeKey. It returns "" for invalid keys.
+type Formatter struct{}
+
+// Format normalizes input as NormalizeKey does.
+func (Formatter) Format(input string) string {
+	normalized, _ := NormalizeKey(input)
+	return normalized
+}
+
+func normalizeKey(key string) (string, KeyType, error) {
+	key = strings.TrimSpace(key)
+
+	switch {
+	case key == "":
+		return "", "", ErrInvalidKey
+	case strings.Contains(key, "@"):
+		return normalizeEmail(key)
+	case evpRegex.MatchString(key) && !helpers.ContainsOnly(key, "0123456789"):
+		return normalizeEVP(key)
+	case strings.HasPrefix(key, "+"):
+		return normalizePhone(key[1:], true)
+	case !helpers.ContainsOnly(strings.ToUpper(key), "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"+documentSymbols):
+		return "", "", ErrInvalidKey
+	}
+
+	if strings.ContainsAny(key, "()") {
+		return normalizePhone(key, false)
+	}
+
+	alphanumerics := strings.ToUpper(helpers.OnlyAlphanumerics(key))
+	switch {
+	case len(alphanumerics) == 14:
+		if !cnpj.IsValid(alphanumerics) {
+			return "", "", ErrInvalidCNPJ
+		}
+		return alphanumerics, CNPJ, nil
+	case alphanumerics != helpers.OnlyNumbers(alphanumerics):
+		return "", "", ErrInvalidKey
+	case len(alphanumerics) == 11 && cpf.IsValid(alphanumerics):
+		return alphanumerics, CPF, nil
+	}
+
+	if len(alphanumerics) == 11 && strings.Contains(key, ".") {
+		return "", "", ErrInvalidCPF
+	}
+
+	normalized, keyType, err := normalizePhone(key, false)
+	if err != nil && len(alphanumerics) == 11 {
+		// Without formatting, an 11-digit key may be a mistyped CPF as well
+		return "", "", ErrInvalidKey
+	}
+
+	return normalized, keyType, err
+}
+
+func normalizeEmail(key string) (string, KeyType, error) {
+	key = strings.ToLower(key)
+	if len(key) > maxEmailLength || !email.IsValid(key) {
+		return "", "", ErrInvalidEmail
+	}
+
+	return key, Email, nil
+}
+
+// normalizeEVP accepts only version 4 UUIDs with the RFC 4122 variant
+func normalizeEVP(key string) (string, KeyType, error) {
+	hex := strings.ToLower(strings.ReplaceAll(key, "-", ""))
+	if hex[12] != '4' || !strings.ContainsRune("89ab", rune(hex[16])) {
+		return "", "", ErrInvalidEVP
+	}
+
+	return hex[0:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:32], EVP, nil
+}
+
+// normalizePhone accepts a phone number with the country code when it is
+// typed after "+", or with or without it otherwise
+func normalizePhone(key string, hasCountryCode bool) (string, KeyType, error) {
+	if !helpers.ContainsOnly(key, "0123456789"+documentSymbols) {
+		return "", "", ErrInvalidPhone
+	}
+
+	digits := helpers.OnlyNumbers(key)
+	if hasCountryCode || len(digits) > 11 {
+		if !strings.HasPrefix(digits, brazilCountryCode) {
+			return "", "", ErrInvalidPhone
+		}
+		digits = digits[len(brazilCountryCode):]
+	}
+
+	if !phone.IsValid(digits, "") {
+		return "", "", ErrInvalidPhone
+	}
+
+	return "+" + brazilCountryCode + digits, Phone, nil
+}
@@ -0,0 +1,67 @@
+package pix_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brazilian-utils/go/pix"
+)
+
+var validateKeyTests = []struct {
+	input      string
+	keyType    pix.KeyType
+	normalized string
+	err        error
+}{
+	{"529.982.247-25", pix.CPF, "52998224725", nil},
+	{" 52998224725 ", pix.CPF, "52998224725", nil},
+	{"60.391.947/0001-00", pix.CNPJ, "60391947000100", nil},
+	{"12.abc.345/01de-35", pix.CNPJ, "12ABC34501DE35", nil},
+	{"+55 (11) 98765-4321", pix.Phone, "+5511987654321", nil},
+	{"+5511987654321", pix.Phone, "+5511987654321", nil},
+	{"(11) 98765-4321", pix.Phone, "+5511987654321", nil},
+	{"11987654321", pix.Phone, "+5511987654321", nil},
+	{"55 11 98765-4321", pix.Phone, "+5511987654321", nil},
+	{"(11) 3456-7890", pix.Phone, "+551134567890", nil},
+	{"Fulano.Silva@Example.COM", pix.Email, "fulano.silva@example.com", nil},
+	{"123E4567-E89B-42D3-A456-426614174000", pix.EVP, "123e4567-e89b-42d3-a456-426614174000", nil},
+	{"123e4567e89b42d3a456426614174000", pix.EVP, "123e4567-e89b-42d3-a456-426614174000", nil},
+	{"", "", "", pix.ErrInvalidKey},
+	{"chave pix", "", "", pix.ErrInvalidKey},
+	{"529.982.247-26", "", "", pix.ErrInvalidCPF},
+	{"12345678901", "", "", pix.ErrInvalidKey},
+	{"60.391.947/0001-01", "", "", pix.ErrInvalidCNPJ},
+	{"+1 415 555 2671", "", "", pix.ErrInvalidPhone},
+	{"(11) 1234-5678", "", "", pix.ErrInvalidPhone},
+	{"fulano@", "", "", pix.ErrInvalidEmail},
+	{"123e4567-e89b-12d3-a456-426614174000", "", "", pix.ErrInvalidEVP},
+	{"123e4567-e89b-42d3-c456-426614174000", "", "", pix.ErrInvalidEVP},
+}
+
+func TestValidateKey(t *testing.T) {
+	for _, table := range validateKeyTests {
+		keyType, err := pix.ValidateKey(table.input)
+		if keyType != table.keyType || !errors.Is(err, table.err) {
+			t.Errorf("Failing for %v \t Expected: %v (%v) | Received: %v (%v)", table.input, table.keyType, table.err, keyType, err)
+		}
+		if res := pix.IsValidKey(table.input); res != (table.err == nil) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.err == nil, res)
+		}
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	for _, table := range validateKeyTests {
+		normalized, err := pix.NormalizeKey(table.input)
+		if normalized != table.normalized || !errors.Is(err, table.err) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v (%v)", table.input, table.normalized, normalized, err)
+		}
+	}
+}
+
+func TestValidateKeyEmailLength(t *testing.T) {
+	local := "a234567890123456789012345678901234567890123456789012345678901234"
+	if _, err := pix.ValidateKey(local + "@example.com.br"); !errors.Is(err, pix.ErrInvalidEmail) {
+		t.Errorf("Expected ErrInvalidEmail, got %v", err)
+	}
+}
@@ -0,0 +1,500 @@
+package qrcode
+
+// This file implements the QR Code model 2 symbology (ISO/IEC 18004) in byte
+// mode with error correction level M, the level the Central Bank recommends
+// for BR Codes.
+
+// Error correction codewords per block and number of blocks of each version
+// at level M, indexed by version
+var (
+	eccCodewordsPerBlock = [41]int{
+		0, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26,
+		26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28,
+	}
+	errorCorrectionBlocks = [41]int{
+		0, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16,
+		17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49,
+	}
+)
+
+// Format bits identifying error correction level M
+const levelMFormatBits = 0
+
+const (
+	minVersion = 1
+	maxVersion = 40
+)
+
+// Mode indicator of byte mode and the pad codewords filling the symbol
+const (
+	byteModeIndicator = 0x4
+	padCodeword1      = 0xEC
+	padCodeword2      = 0x11
+)
+
+// symbol is the module matrix of a QR Code, indexed by row and column.
+type symbol struct {
+	size       int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// encode builds the smallest QR Code holding data in byte mode, choosing the
+// mask with the lowest penalty. ok is false if data does not fit.
+func encode(data []byte) (modules [][]bool, ok bool) {
+	version := minVersion
+	for ; version <= maxVersion; version++ {
+		if dataBits(len(data), version) <= dataCodewords(version)*8 {
+			break
+		}
+	}
+	if version > maxVersion {
+		return nil, false
+	}
+
+	codewords := addErrorCorrection(dataCodewordsFor(data, version), version)
+
+	s := newSymbol(version)
+	s.drawFunctionPatterns(version)
+	s.drawCodewords(codewords)
+
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		s.applyMask(mask)
+		s.drawFormatBits(mask)
+		if penalty := s.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		s.applyMask(mask)
+	}
+
+	s.applyMask(bestMask)
+	s.drawFormatBits(bestMask)
+
+	return s.modules, true
+}
+
+// dataBits returns the bits taken by the mode indicator, character count and
+// data of a byte mode segment
+func dataBits(length int, version int) int {
+	return 4 + characterCountBits(version) + length*8
+}
+
+func characterCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+// rawDataModules returns the number of modules available for data and error
+// correction codewords, after the function patterns
+func rawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		alignments := version/7 + 2
+		result -= (25*alignments-10)*alignments - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+func dataCodewords(version int) int {
+	return rawDataModules(version)/8 - eccCodewordsPerBlock[version]*errorCorrectionBlocks[version]
+}
+
+// dataCodewordsFor encodes data as a byte mode segment followed by the
+// terminator and the pad codewords
+func dataCodewordsFor(data []byte, version int) []byte {
+	var bits bitBuffer
+	bits.write(byteModeIndicator, 4)
+	bits.write(len(data), characterCountBits(version))
+	for _, b := range data {
+		bits.write(int(b), 8)
+	}
+
+	capacity := dataCodewords(version) * 8
+	bits.write(0, min(4, capacity-len(bits)))
+	bits.write(0, (8-len(bits)%8)%8)
+	for pad := padCodeword1; len(bits) < capacity; pad ^= padCodeword1 ^ padCodeword2 {
+		bits.write(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for index, bit := range bits {
+		if bit {
+			codewords[index/8] |= 1 << (7 - index%8)
+		}
+	}
+	return codewords
+}
+
+// bitBuffer is a sequence of bits, most significant first.
+type bitBuffer []bool
+
+func (b *bitBuffer) write(value int, length int) {
+	for bit := length - 1; bit >= 0; bit-- {
+		*b = append(*b, (value>>bit)&1 == 1)
+	}
+}
+
+// addErrorCorrection splits the data codewords into blocks, appends the
+// Reed-Solomon codewords of each one and interleaves them
+func addErrorCorrection(data []byte, version int) []byte {
+	blocks := errorCorrectionBlocks[version]
+	eccLength := eccCodewordsPerBlock[version]
+	rawCodewords := rawDataModules(version) / 8
+	shortBlocks := blocks - rawCodewords%blocks
+	shortBlockLength := rawCodewords / blocks
+
+	divisor := reedSolomonDivisor(eccLength)
+	dataBlocks := make([][]byte, blocks)
+	eccBlocks := make([][]byte, blocks)
+	for index, offset := 0, 0; index < blocks; index++ {
+		length := shortBlockLength - eccLength
+		if index >= shortBlocks {
+			length++
+		}
+
+		dataBlocks[index] = data[offset : offset+length]
+		eccBlocks[index] = reedSolomonRemainder(dataBlocks[index], divisor)
+		offset += length
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for index := 0; index <= shortBlockLength-eccLength; index++ {
+		for _, block := range dataBlocks {
+			if index < len(block) {
+				result = append(result, block[index])
+			}
+		}
+	}
+	for index := 0; index < eccLength; index++ {
+		for _, block := range eccBlocks {
+			result = append(result, block[index])
+		}
+	}
+
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of the given degree,
+// without its leading coefficient
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for range degree {
+		for index := range result {
+			result[index] = gfMultiply(result[index], root)
+			if index+1 < len(result) {
+				result[index] ^= result[index+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+func reedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for index, coefficient := range divisor {
+			result[index] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x byte, y byte) byte {
+	var z int
+	for bit := 7; bit >= 0; bit-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>bit)&1) * int(x)
+	}
+	return byte(z)
+}
+
+func newSymbol(version int) *symbol {
+	size := version*4 + 17
+	s := &symbol{size: size, modules: make([][]bool, size), isFunction: make([][]bool, size)}
+	for row := range s.modules {
+		s.modules[row] = make([]bool, size)
+		s.isFunction[row] = make([]bool, size)
+	}
+	return s
+}
+
+func (s *symbol) setFunction(x int, y int, dark bool) {
+	s.modules[y][x] = dark
+	s.isFunction[y][x] = true
+}
+
+// drawFunctionPatterns draws the timing, finder and alignment patterns and
+// the version information, and reserves the format information area
+func (s *symbol) drawFunctionPatterns(version int) {
+	for index := 0; index < s.size; index++ {
+		s.setFunction(6, index, index%2 == 0)
+		s.setFunction(index, 6, index%2 == 0)
+	}
+
+	s.drawFinderPattern(3, 3)
+	s.drawFinderPattern(s.size-4, 3)
+	s.drawFinderPattern(3, s.size-4)
+
+	positions := alignmentPatternPositions(version, s.size)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			s.drawAlignmentPattern(x, y)
+		}
+	}
+
+	s.drawFormatBits(0)
+	s.drawVersion(version)
+}
+
+func (s *symbol) drawFinderPattern(x int, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			distance := max(abs(dx), abs(dy))
+			if xx, yy := x+dx, y+dy; xx >= 0 && xx < s.size && yy >= 0 && yy < s.size {
+				s.setFunction(xx, yy, distance != 2 && distance != 4)
+			}
+		}
+	}
+}
+
+func (s *symbol) drawAlignmentPattern(x int, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			s.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// alignmentPatternPositions returns the row and column coordinates of the
+// alignment pattern centers
+func alignmentPatternPositions(version int, size int) []int {
+	if version == 1 {
+		return nil
+	}
+
+	count := version/7 + 2
+	step := (version*8 + count*3 + 5) / (count*4 - 4) * 2
+
+	positions := make([]int, count)
+	positions[0] = 6
+	for index, position := count-1, size-7; index >= 1; index, position = index-1, position-step {
+		positions[index] = position
+	}
+	return positions
+}
+
+// drawFormatBits draws both copies of the error correction level and mask,
+// protected by a BCH code, and the dark module
+func (s *symbol) drawFormatBits(mask int) {
+	data := levelMFormatBits<<3 | mask
+	remainder := data
+	for range 10 {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	bits := (data<<10 | remainder) ^ 0x5412
+
+	bit := func(index int) bool { return (bits>>index)&1 == 1 }
+
+	for index := 0; index <= 5; index++ {
+		s.setFunction(8, index, bit(index))
+	}
+	s.setFunction(8, 7, bit(6))
+	s.setFunction(8, 8, bit(7))
+	s.setFunction(7, 8, bit(8))
+	for index := 9; index < 15; index++ {
+		s.setFunction(14-index, 8, bit(index))
+	}
+
+	for index := 0; index < 8; index++ {
+		s.setFunction(s.size-1-index, 8, bit(index))
+	}
+	for index := 8; index < 15; index++ {
+		s.setFunction(8, s.size-15+index, bit(index))
+	}
+	s.setFunction(8, s.size-8, true)
+}
+
+// drawVersion draws both copies of the version information, present from
+// version 7 on
+func (s *symbol) drawVersion(version int) {
+	if version < 7 {
+		return
+	}
+
+	remainder := version
+	for range 12 {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	bits := version<<12 | remainder
+
+	for index := 0; index < 18; index++ {
+		dark := (bits>>index)&1 == 1
+		a, b := s.size-11+index%3, index/3
+		s.setFunction(a, b, dark)
+		s.setFunction(b, a, dark)
+	}
+}
+
+// drawCodewords places the codewords in the zigzag order, in pairs of columns
+// from the bottom right corner, skipping the function patterns
+func (s *symbol) drawCodewords(codewords []byte) {
+	index := 0
+	for right := s.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < s.size; vertical++ {
+			for column := 0; column < 2; column++ {
+				x := right - column
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = s.size - 1 - vertical
+				}
+				if !s.isFunction[y][x] && index < len(codewords)*8 {
+					s.modules[y][x] = (codewords[index/8]>>(7-index%8))&1 == 1
+					index++
+				}
+			}
+		}
+	}
+}
+
+// applyMask inverts the data modules selected by the mask pattern. Applying
+// the same mask twice undoes it.
+func (s *symbol) applyMask(mask int) {
+	for y := 0; y < s.size; y++ {
+		for x := 0; x < s.size; x++ {
+			if !s.isFunction[y][x] && maskInverts(mask, x, y) {
+				s.modules[y][x] = !s.modules[y][x]
+			}
+		}
+	}
+}
+
+func maskInverts(mask int, x int, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// Penalty weights of the mask evaluation rules
+const (
+	penaltyRun     = 3
+	penaltyBlock   = 3
+	penaltyFinder  = 40
+	penaltyBalance = 10
+)
+
+// Module sequences that look like a finder pattern next to light modules
+var finderLikePatterns = [][]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// penalty evaluates the masked symbol with the four rules of the standard:
+// runs of the same color, 2x2 blocks, finder-like patterns and the balance
+// of dark and light modules
+func (s *symbol) penalty() int {
+	result := 0
+	dark := 0
+
+	for y := 0; y < s.size; y++ {
+		row := s.modules[y]
+		column := make([]bool, s.size)
+		for x := 0; x < s.size; x++ {
+			column[x] = s.modules[x][y]
+			if row[x] {
+				dark++
+			}
+			if y+1 < s.size && x+1 < s.size &&
+				row[x] == row[x+1] && row[x] == s.modules[y+1][x] && row[x] == s.modules[y+1][x+1] {
+				result += penaltyBlock
+			}
+		}
+
+		result += runPenalty(row) + runPenalty(column)
+		result += finderPenalty(row) + finderPenalty(column)
+	}
+
+	total := s.size * s.size
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	result += k * penaltyBalance
+
+	return result
+}
+
+func runPenalty(line []bool) int {
+	result := 0
+	run := 1
+	for index := 1; index <= len(line); index++ {
+		if index < len(line) && line[index] == line[index-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += penaltyRun + run - 5
+		}
+		run = 1
+	}
+	return result
+}
+
+func finderPenalty(line []bool) int {
+	result := 0
+	for _, pattern := range finderLikePatterns {
+		for start := 0; start+len(pattern) <= len(line); start++ {
+			matches := true
+			for offset, dark := range pattern {
+				if line[start+offset] != dark {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				result += penaltyFinder
+			}
+		}
+	}
+	return result
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,73 @@
+package qrcode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// Version 1-M example of ISO/IEC 18004 Annex I, which encodes "01234567"
+func TestReedSolomonRemainder(t *testing.T) {
+	data := []byte{0x10, 0x20, 0x0C, 0x56, 0x61, 0x80, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11}
+	expected := []byte{0xA5, 0x24, 0xD4, 0xC1, 0xED, 0x36, 0xC7, 0x87, 0x2C, 0x55}
+
+	if res := reedSolomonRemainder(data, reedSolomonDivisor(10)); !bytes.Equal(res, expected) {
+		t.Errorf("Expected: %X | Received: %X", expected, res)
+	}
+}
+
+var versionTests = []struct {
+	length  int
+	version int
+}{
+	{1, 1},
+	{14, 1},
+	{15, 2},
+	{106, 6},
+	{107, 7},
+	{2331, 40},
+}
+
+func TestEncodeVersion(t *testing.T) {
+	for _, table := range versionTests {
+		modules, ok := encode([]byte(strings.Repeat("a", table.length)))
+		if !ok {
+			t.Fatalf("Failing for %v \t Unexpected overflow", table.length)
+		}
+		if expected := table.version*4 + 17; len(modules) != expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.length, expected, len(modules))
+		}
+	}
+
+	if _, ok := encode([]byte(strings.Repeat("a", 2332))); ok {
+		t.Errorf("Expected 2332 bytes to overflow version 40")
+	}
+}
+
+var dataCodewordsTests = []struct {
+	version  int
+	expected int
+}{
+	{1, 16},
+	{6, 108},
+	{10, 216},
+	{40, 2334},
+}
+
+func TestDataCodewords(t *testing.T) {
+	for _, table := range dataCodewordsTests {
+		if res := dataCodewords(table.version); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.version, table.expected, res)
+		}
+	}
+}
+
+func TestAlignmentPatternPositions(t *testing.T) {
+	res := alignmentPatternPositions(32, 32*4+17)
+	expected := []int{6, 34, 60, 86, 112, 138}
+	for index := range expected {
+		if index >= len(res) || res[index] != expected[index] {
+			t.Fatalf("Expected: %v | Received: %v", expected, res)
+		}
+	}
+}
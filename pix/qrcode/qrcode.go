@@ -0,0 +1,181 @@
+// Package qrcode renders PIX BR Code payloads as QR Codes, as an
+// image.Image, a PNG or an SVG, using only the standard library.
+//
+// Codes use error correction level M and the smallest version that holds the
+// payload, as the Central Bank's BR Code manual recommends, with a quiet zone
+// of 4 modules.
+package qrcode
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/brazilian-utils/go/pix"
+)
+
+// Errors returned when encoding or building the options.
+var (
+	ErrPayloadTooLong    = errors.New("qrcode: payload too long for a QR Code")
+	ErrInvalidModuleSize = errors.New("qrcode: invalid module size")
+	ErrInvalidQuietZone  = errors.New("qrcode: quiet zone must have at least 4 modules")
+)
+
+// Defaults print a typical BR Code about 3 cm wide at 300 dpi, above the
+// minimum size readers need
+const (
+	defaultModuleSize = 8
+	defaultQuietZone  = 4
+)
+
+// Option configures the rendering.
+type Option func(*options) error
+
+type options struct {
+	moduleSize int
+	quietZone  int
+}
+
+// WithModuleSize sets the width and height of each module, in pixels.
+func WithModuleSize(pixels int) Option {
+	return func(o *options) error {
+		if pixels < 1 {
+			return ErrInvalidModuleSize
+		}
+
+		o.moduleSize = pixels
+		return nil
+	}
+}
+
+// WithQuietZone sets the blank margin on each side, in modules. QR Code
+// readers need at least 4.
+func WithQuietZone(modules int) Option {
+	return func(o *options) error {
+		if modules < defaultQuietZone {
+			return ErrInvalidQuietZone
+		}
+
+		o.quietZone = modules
+		return nil
+	}
+}
+
+// Encode returns the modules of the QR Code of a BR Code payload, indexed by
+// row and column, where true is a dark module.
+// Returns the error reported by pix.Decode if the payload is not a valid BR
+// Code, or ErrPayloadTooLong if it does not fit in a QR Code.
+func Encode(payload string) ([][]bool, error) {
+	if _, err := pix.Decode(payload); err != nil {
+		return nil, err
+	}
+
+	modules, ok := encode([]byte(strings.TrimSpace(payload)))
+	if !ok {
+		return nil, ErrPayloadTooLong
+	}
+
+	return modules, nil
+}
+
+// Image renders the QR Code of a BR Code payload as a black and white image.
+// Returns the errors of Encode, or the error of an invalid option.
+func Image(payload string, opts ...Option) (image.Image, error) {
+	o, modules, err := prepare(payload, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	size := o.totalSize(modules)
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for index := range img.Pix {
+		img.Pix[index] = 0xff
+	}
+
+	for row, line := range modules {
+		for column, dark := range line {
+			if !dark {
+				continue
+			}
+			x0 := (o.quietZone + column) * o.moduleSize
+			y0 := (o.quietZone + row) * o.moduleSize
+			for y := y0; y < y0+o.moduleSize; y++ {
+				for x := x0; x < x0+o.moduleSize; x++ {
+					img.SetGray(x, y, color.Gray{Y: 0})
+				}
+			}
+		}
+	}
+
+	return img, nil
+}
+
+// WritePNG renders the QR Code of a BR Code payload as a PNG image into w.
+// Returns the errors of Image, or the error writing to w.
+func WritePNG(w io.Writer, payload string, opts ...Option) error {
+	img, err := Image(payload, opts...)
+	if err != nil {
+		return err
+	}
+
+	return png.Encode(w, img)
+}
+
+// WriteSVG renders the QR Code of a BR Code payload as an SVG document into
+// w, with a single path covering the dark modules.
+// Returns the errors of Encode, the error of an invalid option, or the error
+// writing to w.
+func WriteSVG(w io.Writer, payload string, opts ...Option) error {
+	o, modules, err := prepare(payload, opts)
+	if err != nil {
+		return err
+	}
+
+	size := o.totalSize(modules)
+	viewBox := len(modules) + 2*o.quietZone
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, viewBox, viewBox)
+	fmt.Fprintf(&svg, `<rect width="%d" height="%d" fill="#fff"/>`, viewBox, viewBox)
+	svg.WriteString(`<path d="`)
+	for row, line := range modules {
+		for column, dark := range line {
+			if dark {
+				fmt.Fprintf(&svg, "M%d %dh1v1h-1z", o.quietZone+column, o.quietZone+row)
+			}
+		}
+	}
+	svg.WriteString(`"/></svg>`)
+
+	_, err = io.WriteString(w, svg.String())
+	return err
+}
+
+func prepare(payload string, opts []Option) (*options, [][]bool, error) {
+	o := &options{
+		moduleSize: defaultModuleSize,
+		quietZone:  defaultQuietZone,
+	}
+	for _, option := range opts {
+		if err := option(o); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	modules, err := Encode(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return o, modules, nil
+}
+
+// totalSize returns the width and height of the code and its quiet zones, in
+// pixels
+func (o *options) totalSize(modules [][]bool) int {
+	return (len(modules) + 2*o.quietZone) * o.moduleSize
+}
@@ -0,0 +1,193 @@
+package qrcode_test
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/pix"
+	"github.com/brazilian-utils/go/pix/qrcode"
+)
+
+const brCode = "00020126360014br.gov.bcb.pix0114+551198765432152040000530398654071234.505802BR5914Joao Sebastiao6009Sao Paulo62120508PEDIDO426304EB26"
+
+func TestEncode(t *testing.T) {
+	modules, err := qrcode.Encode(brCode)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 133 bytes need version 8 (49x49) at level M
+	if len(modules) != 49 || len(modules[0]) != 49 {
+		t.Fatalf("Expected 49x49 modules, got %vx%v", len(modules), len(modules[0]))
+	}
+
+	// Finder patterns: dark outer ring, light separator ring, dark center
+	for _, corner := range [][2]int{{0, 0}, {0, 42}, {42, 0}} {
+		row, column := corner[0], corner[1]
+		if !modules[row][column] || modules[row+1][column+1] || !modules[row+3][column+3] {
+			t.Errorf("Missing finder pattern at %v", corner)
+		}
+	}
+}
+
+// Reference symbol for brCode at error correction level M, produced by an
+// independent encoder (rsc.io/qr) with mask pattern 2. "#" is a dark module.
+var brCodeSymbol = []string{
+	"#######...#...##.##..#..######..#..###..#.#######",
+	"#.....#...#...#..#...####...#.####.##.###.#.....#",
+	"#.###.#.#.#.#.#.##.#..#.#.###.####.#...##.#.###.#",
+	"#.###.#.####.#.#.##..###...#.....####..#..#.###.#",
+	"#.###.#.###...#.##.########.###.##.###....#.###.#",
+	"#.....#.###..#....#.###...#.#..#.##.###...#.....#",
+	"#######.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#.#######",
+	"........##...########.#...#.#..###.#.............",
+	"#.#####...#....#.#.##.######..#..#..#.#.#.#####..",
+	"###.#...##..#..#.##...#.....#.##.#..#..#.##..#.#.",
+	".....####..##.##.##.##.#.##..#..#.#.####..##.##.#",
+	"####.....######.###.........#.#.##.#.##...###...#",
+	"#..#..#.#.##..######.#####....##..#########......",
+	"####.#.###.#.#.###...#.##.....#.#.........##.#.#.",
+	"..#.#####...#..#####...#######.##.#.#.#..#...#..#",
+	"..####..###.##.#.....#........##.#..#..#..####..#",
+	"#.##.##..#..#.#....##...#..#.##..####.#.##....#.#",
+	".#..##.#.#.#...####..##.##....##.#..#..#..#..#.#.",
+	"...#..#.##....#...##..####.#.##...#####..#.#.#..#",
+	".##....##..#####.#...#.#...##.###.#......#####.##",
+	"#.#####.#.##..#....##.#.##...#...####...#.#...###",
+	"###..#.#.##.##.####....##....####......#.##.....#",
+	".#..#####.#..#.#.##..#######...####.###.#####.###",
+	"#..##...#.##..##.##.#.#...#.#####..#....#...##...",
+	".####.#.##.##.....#####.#.##........#..##.#.#.#.#",
+	".#.##...#.#.#...###..##...##..####..#..##...##.#.",
+	"....############...#..######.#....#.###.#######.#",
+	"#...#..#####....##....#.##.##.#.#.........#..#.##",
+	"...#.#####.##..##.#...###.....#..#.##..##.....#..",
+	"#....#.###........#####.#..#..#.#...##..####.###.",
+	".###..#.##.##..###..#..##..#.#.#.#####...###.#..#",
+	"##..##..####..###..##.#.####.#...#.#.#..#.##...##",
+	"#..#..#.....###.#####.##....#..####.#.###.###..##",
+	"######...#.###.#.#.....##.####..##..#...#....#.#.",
+	"...#..#..#..#..###..#####...#.###.#.###.#.##.#..#",
+	"##..#..#....##.#.##..#..#####.#.#........#..##..#",
+	"#.#.###.###.##.....#####.......#..###..##.###.#.#",
+	".#.#...##....##.###..##.#...#.##...##..#.......#.",
+	".#...###..#..#.#...#...#.#.#.....########.##.##.#",
+	".###...#####..##.###.##.#.#.######.#.#...#.#.#..#",
+	"###...##..##.##.############.##...#.#..######.#.#",
+	"........##.#....##...##...##..#..#..#..##...#..#.",
+	"#######..#..#.#.####.##.#.#..#....#.###.#.#.#...#",
+	"#.....#.#..#..#..#.#.##...#.#..#####....#...##.##",
+	"#.###.#.#...###..###.######..###....#########.#.#",
+	"#.###.#.##.###...#....##.########..###.#...###..#",
+	"#.###.#.##.#.##.#..#....#.#.#######.#.#......#...",
+	"#.....#...#.#######..######...#....#...#.##.#...#",
+	"#######.#..#.###..####..#..#.##..##.###......#.##",
+}
+
+func TestEncodeGolden(t *testing.T) {
+	modules, err := qrcode.Encode(brCode)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(modules) != len(brCodeSymbol) {
+		t.Fatalf("Expected %d rows, got %d", len(brCodeSymbol), len(modules))
+	}
+
+	for y, row := range modules {
+		var line strings.Builder
+		for _, dark := range row {
+			if dark {
+				line.WriteByte('#')
+			} else {
+				line.WriteByte('.')
+			}
+		}
+		if line.String() != brCodeSymbol[y] {
+			t.Errorf("Failing for row %d \t Expected: %v | Received: %v", y, brCodeSymbol[y], line.String())
+		}
+	}
+}
+
+var encodeErrorTests = []struct {
+	input    string
+	expected error
+}{
+	{"", pix.ErrInvalidPayload},
+	{brCode[:len(brCode)-4] + "0000", pix.ErrInvalidCRC},
+}
+
+func TestEncodeErrors(t *testing.T) {
+	for _, table := range encodeErrorTests {
+		if _, err := qrcode.Encode(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
+
+func TestImage(t *testing.T) {
+	img, err := qrcode.Image(brCode, qrcode.WithModuleSize(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 49 modules and a quiet zone of 4 on each side
+	if bounds := img.Bounds(); bounds.Dx() != 114 || bounds.Dy() != 114 {
+		t.Errorf("Unexpected bounds: %v", bounds)
+	}
+	if c := color.GrayModel.Convert(img.At(7, 7)).(color.Gray); c.Y != 0xff {
+		t.Errorf("Expected a white quiet zone, got %v", c)
+	}
+	if c := color.GrayModel.Convert(img.At(8, 8)).(color.Gray); c.Y != 0 {
+		t.Errorf("Expected a black finder pattern, got %v", c)
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := qrcode.WritePNG(&buf, brCode, qrcode.WithQuietZone(6)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := (49 + 12) * 8; img.Bounds().Dx() != expected {
+		t.Errorf("Expected width %v, got %v", expected, img.Bounds().Dx())
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := qrcode.WriteSVG(&buf, brCode); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	svg := buf.String()
+	if !strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="456" height="456" viewBox="0 0 57 57"`) {
+		t.Errorf("Unexpected SVG header: %v", svg[:90])
+	}
+	if !strings.Contains(svg, `M4 4h1v1h-1z`) {
+		t.Errorf("Expected the top left module to be dark")
+	}
+}
+
+var optionErrorTests = []struct {
+	option   qrcode.Option
+	expected error
+}{
+	{qrcode.WithModuleSize(0), qrcode.ErrInvalidModuleSize},
+	{qrcode.WithQuietZone(3), qrcode.ErrInvalidQuietZone},
+}
+
+func TestOptionErrors(t *testing.T) {
+	for _, table := range optionErrorTests {
+		if _, err := qrcode.Image(brCode, table.option); !errors.Is(err, table.expected) {
+			t.Errorf("Expected: %v | Received: %v", table.expected, err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package brutils
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/brazilian-utils/go/boleto"
+	"github.com/brazilian-utils/go/cep"
+	"github.com/brazilian-utils/go/cnh"
+	"github.com/brazilian-utils/go/cnpj"
+	"github.com/brazilian-utils/go/cpf"
+	"github.com/brazilian-utils/go/email"
+	"github.com/brazilian-utils/go/legalnature"
+	"github.com/brazilian-utils/go/legalprocess"
+	"github.com/brazilian-utils/go/licenseplate"
+	"github.com/brazilian-utils/go/phone"
+	"github.com/brazilian-utils/go/pis"
+	"github.com/brazilian-utils/go/pix"
+	"github.com/brazilian-utils/go/renavam"
+	"github.com/brazilian-utils/go/voterid"
+)
+
+// DocumentType identifies a kind of Brazilian document. Its value is the
+// lowercase package name, so it can be read from configuration with
+// DocumentType(name).
+type DocumentType string
+
+// Supported document types.
+const (
+	CPF          DocumentType = "cpf"
+	CNPJ         DocumentType = "cnpj"
+	CEP          DocumentType = "cep"
+	PIS          DocumentType = "pis"
+	CNH          DocumentType = "cnh"
+	RENAVAM      DocumentType = "renavam"
+	VoterID      DocumentType = "voterid"
+	Boleto       DocumentType = "boleto"
+	LegalProcess DocumentType = "legalprocess"
+	LegalNature  DocumentType = "legalnature"
+	Email        DocumentType = "email"
+	Phone        DocumentType = "phone"
+	LicensePlate DocumentType = "licenseplate"
+	PixKey       DocumentType = "pix"
+)
+
+var registryMu sync.RWMutex
+
+var validators = map[DocumentType]Validator{
+	CPF:          cpf.Validator{},
+	CNPJ:         cnpj.Validator{},
+	CEP:          cep.Validator{},
+	PIS:          pis.Validator{},
+	CNH:          cnh.Validator{},
+	RENAVAM:      renavam.Validator{},
+	VoterID:      voterid.Validator{},
+	Boleto:       boleto.Validator{},
+	LegalProcess: legalprocess.Validator{},
+	LegalNature:  legalnature.Validator{},
+	Email:        email.Validator{},
+	Phone:        phone.Validator{},
+	LicensePlate: licenseplate.Validator{},
+	PixKey:       pix.Validator{},
+}
+
+var formatters = map[DocumentType]Formatter{
+	CPF:          cpf.Formatter{},
+	CNPJ:         cnpj.Formatter{},
+	CEP:          cep.Formatter{},
+	PIS:          pis.Formatter{},
+	VoterID:      voterid.Formatter{},
+	LegalProcess: legalprocess.Formatter{},
+	Phone:        phone.Formatter{},
+	LicensePlate: licenseplate.Formatter{},
+	PixKey:       pix.Formatter{},
+}
+
+// ValidatorFor returns the Validator registered for the document type.
+// ok is false if there is none.
+func ValidatorFor(documentType DocumentType) (validator Validator, ok bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	validator, ok = validators[documentType]
+	return
+}
+
+// FormatterFor returns the Formatter registered for the document type.
+// ok is false if there is none.
+func FormatterFor(documentType DocumentType) (formatter Formatter, ok bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	formatter, ok = formatters[documentType]
+	return
+}
+
+// RegisterValidator registers the Validator for a document type, replacing
+// any previous one. It can be used to add custom document types.
+func RegisterValidator(documentType DocumentType, validator Validator) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	validators[documentType] = validator
+}
+
+// RegisterFormatter registers the Formatter for a document type, replacing
+// any previous one. It can be used to add custom document types.
+func RegisterFormatter(documentType DocumentType, formatter Formatter) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	formatters[documentType] = formatter
+}
+
+// UnregisterValidator removes the Validator registered for a document type,
+// if any.
+func UnregisterValidator(documentType DocumentType) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(validators, documentType)
+}
+
+// UnregisterFormatter removes the Formatter registered for a document type,
+// if any.
+func UnregisterFormatter(documentType DocumentType) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	delete(formatters, documentType)
+}
+
+// DocumentTypes returns the document types with a registered Validator,
+// sorted by name.
+func DocumentTypes() []DocumentType {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	types := make([]DocumentType, 0, len(validators))
+	for documentType := range validators {
+		types = append(types, documentType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
@@ -0,0 +1,120 @@
+package brutils_test
+
+import (
+	"strings"
+	"testing"
+
+	brutils "github.com/brazilian-utils/go"
+	"github.com/brazilian-utils/go/phone"
+)
+
+var registryTests = []struct {
+	documentType brutils.DocumentType
+	input        string
+	valid        bool
+	formatted    string
+}{
+	{brutils.CPF, "40364478829", true, "403.644.788-29"},
+	{brutils.CNPJ, "11222333000181", true, "11.222.333/0001-81"},
+	{brutils.CEP, "01001000", true, "01001-000"},
+	{brutils.PIS, "12345678900", true, "123.45678.90-0"},
+	{brutils.VoterID, "690847092828", true, "6908 4709 28 28"},
+	{brutils.LegalProcess, "68476506020233030000", true, "6847650-60.2023.3.03.0000"},
+	{brutils.Phone, "11994029275", true, "(11)99402-9275"},
+	{brutils.LicensePlate, "abc1234", true, "ABC-1234"},
+	{brutils.PixKey, "(11) 98765-4321", true, "+5511987654321"},
+	{brutils.CNH, "98765432100", true, ""},
+	{brutils.RENAVAM, "86769597308", true, ""},
+	{brutils.Boleto, "000111", false, ""},
+	{brutils.LegalNature, "2062", true, ""},
+	{brutils.Email, "user@example.org", true, ""},
+}
+
+func TestValidatorFor(t *testing.T) {
+	for _, table := range registryTests {
+		validator, ok := brutils.ValidatorFor(table.documentType)
+		if !ok {
+			t.Errorf("No validator registered for %v", table.documentType)
+			continue
+		}
+		if res := validator.Validate(table.input); res != table.valid {
+			t.Errorf("Failing for %v %v \t Expected: %v | Received: %v", table.documentType, table.input, table.valid, res)
+		}
+	}
+}
+
+func TestFormatterFor(t *testing.T) {
+	for _, table := range registryTests {
+		formatter, ok := brutils.FormatterFor(table.documentType)
+		if ok != (table.formatted != "") {
+			t.Errorf("Unexpected formatter registration for %v: %v", table.documentType, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if res := formatter.Format(table.input); res != table.formatted {
+			t.Errorf("Failing for %v %v \t Expected: %v | Received: %v", table.documentType, table.input, table.formatted, res)
+		}
+	}
+}
+
+func TestLookupByName(t *testing.T) {
+	validator, ok := brutils.ValidatorFor(brutils.DocumentType("cnpj"))
+	if !ok || !validator.Validate("11.222.333/0001-81") {
+		t.Error("Expected CNPJ validator to be found by name")
+	}
+
+	if _, ok := brutils.ValidatorFor("unknown"); ok {
+		t.Error("Expected no validator for unknown document type")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	custom := brutils.DocumentType("mobile")
+	brutils.RegisterValidator(custom, phone.Validator{Type: "mobile"})
+	brutils.RegisterFormatter(custom, brutils.FormatterFunc(strings.ToUpper))
+	t.Cleanup(func() {
+		brutils.UnregisterValidator(custom)
+		brutils.UnregisterFormatter(custom)
+	})
+
+	validator, ok := brutils.ValidatorFor(custom)
+	if !ok || !validator.Validate("11994029275") || validator.Validate("1635014415") {
+		t.Error("Expected registered mobile validator")
+	}
+
+	formatter, ok := brutils.FormatterFor(custom)
+	if !ok || formatter.Format("abc") != "ABC" {
+		t.Error("Expected registered formatter")
+	}
+
+	found := false
+	for _, documentType := range brutils.DocumentTypes() {
+		found = found || documentType == custom
+	}
+	if !found {
+		t.Errorf("Expected %v in DocumentTypes()", custom)
+	}
+}
+
+func TestUnregister(t *testing.T) {
+	custom := brutils.DocumentType("uppercase")
+	brutils.RegisterValidator(custom, phone.Validator{})
+	brutils.RegisterFormatter(custom, brutils.FormatterFunc(strings.ToUpper))
+
+	brutils.UnregisterValidator(custom)
+	brutils.UnregisterFormatter(custom)
+
+	if _, ok := brutils.ValidatorFor(custom); ok {
+		t.Error("Expected no validator after UnregisterValidator")
+	}
+	if _, ok := brutils.FormatterFor(custom); ok {
+		t.Error("Expected no formatter after UnregisterFormatter")
+	}
+	for _, documentType := range brutils.DocumentTypes() {
+		if documentType == custom {
+			t.Errorf("Expected %v not to be in DocumentTypes()", custom)
+		}
+	}
+}
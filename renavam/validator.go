@@ -1,5 +1,15 @@
 package renavam
 
+import "errors"
+
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength     = errors.New("renavam: invalid length")
+	ErrInvalidCharacters = errors.New("renavam: invalid characters")
+	ErrBlacklisted       = errors.New("renavam: blacklisted repeated sequence")
+	ErrInvalidCheckDigit = errors.New("renavam: invalid check digit")
+)
+
 const renavamSize = 11
 
 var weights = []int{2, 3, 4, 5, 6, 7, 8, 9, 2, 3}
@@ -8,11 +18,34 @@ var weights = []int{2, 3, 4, 5, 6, 7, 8, 9, 2, 3}
 // A valid RENAVAM is exactly 11 digits, not all the same digit, with a
 // correct check digit.
 func IsValid(renavam string) bool {
-	if len(renavam) != renavamSize || !isDigits(renavam) || allSame(renavam) {
-		return false
+	return Validate(renavam) == nil
+}
+
+// Validate validates a RENAVAM and returns the reason why it is invalid,
+// or nil if it is valid.
+func Validate(renavam string) error {
+	if renavam != "" && !isDigits(renavam) {
+		return ErrInvalidCharacters
+	}
+	if len(renavam) != renavamSize {
+		return ErrInvalidLength
 	}
+	if allSame(renavam) {
+		return ErrBlacklisted
+	}
+	if checkDigit(renavam) != int(renavam[10]-'0') {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// Validator implements the brutils.Validator interface for RENAVAMs using IsValid.
+type Validator struct{}
 
-	return checkDigit(renavam) == int(renavam[10]-'0')
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
 }
 
 // checkDigit computes the verification digit from the first 10 digits (reversed).
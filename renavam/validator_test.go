@@ -1,6 +1,7 @@
 package renavam_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/renavam"
@@ -29,3 +30,23 @@ func TestValidate(t *testing.T) {
 		}
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"86769597308", nil},
+	{"12345678", renavam.ErrInvalidLength},
+	{"", renavam.ErrInvalidLength},
+	{"1234567890a", renavam.ErrInvalidCharacters},
+	{"00000000000", renavam.ErrBlacklisted},
+	{"86769597309", renavam.ErrInvalidCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := renavam.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}
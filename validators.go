@@ -3,3 +3,11 @@ package brutils
 type Validator interface {
 	Validate(input string) bool
 }
+
+// ValidatorFunc adapts a validation function to the Validator interface.
+type ValidatorFunc func(input string) bool
+
+// Validate calls f(input).
+func (f ValidatorFunc) Validate(input string) bool {
+	return f(input)
+}
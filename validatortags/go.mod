@@ -0,0 +1,24 @@
+module github.com/brazilian-utils/go/validatortags
+
+go 1.23.5
+
+require (
+	github.com/brazilian-utils/go v0.0.0-00010101000000-000000000000
+	github.com/go-playground/validator/v10 v10.27.0
+)
+
+require (
+	github.com/gabriel-vasile/mimetype v1.4.8 // indirect
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/leodido/go-urn v1.4.0 // indirect
+	golang.org/x/crypto v0.33.0 // indirect
+	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
+
+// The validators this module registers are not in a tagged release of the
+// core library yet. Require that release and drop this replace once it is
+// published.
+replace github.com/brazilian-utils/go => ../
@@ -0,0 +1,80 @@
+// Package validatortags registers struct tags for Brazilian documents in
+// github.com/go-playground/validator, backed by the brutils validators.
+//
+// It lives in its own module so the core library keeps no dependencies.
+package validatortags
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/brazilian-utils/go/boleto"
+	"github.com/brazilian-utils/go/cep"
+	"github.com/brazilian-utils/go/cnh"
+	"github.com/brazilian-utils/go/cnpj"
+	"github.com/brazilian-utils/go/cpf"
+	"github.com/brazilian-utils/go/legalnature"
+	"github.com/brazilian-utils/go/legalprocess"
+	"github.com/brazilian-utils/go/licenseplate"
+	"github.com/brazilian-utils/go/phone"
+	"github.com/brazilian-utils/go/pis"
+	"github.com/brazilian-utils/go/renavam"
+	"github.com/brazilian-utils/go/voterid"
+)
+
+// tags maps each registered tag to the function that validates it.
+var tags = map[string]func(string) bool{
+	"cpf":            cpf.IsValid,
+	"cnpj":           cnpj.IsValid,
+	"cpfcnpj":        isCPFOrCNPJ,
+	"cep":            cep.IsValid,
+	"phone_mobile":   func(s string) bool { return phone.IsValid(s, "mobile") },
+	"phone_landline": func(s string) bool { return phone.IsValid(s, "landline") },
+	"pis":            pis.IsValid,
+	"cnh":            cnh.IsValid,
+	"renavam":        renavam.IsValid,
+	"voterid":        voterid.IsValid,
+	"boleto":         boleto.IsValid,
+	"legalprocess":   legalprocess.IsValid,
+	"licenseplate":   func(s string) bool { return licenseplate.IsValid(s, "") },
+	"legalnature":    legalnature.IsValid,
+}
+
+// Register registers every Brazilian document tag on v. Only string fields are
+// validated; any other kind of field fails validation.
+func Register(v *validator.Validate) error {
+	for tag, isValid := range tags {
+		if err := v.RegisterValidation(tag, validationFunc(isValid)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// New returns a validator.Validate with every Brazilian document tag registered.
+func New(options ...validator.Option) *validator.Validate {
+	v := validator.New(options...)
+	if err := Register(v); err != nil {
+		// The tags are constants known to be valid.
+		panic(err)
+	}
+
+	return v
+}
+
+func validationFunc(isValid func(string) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		return isValid(field.String())
+	}
+}
+
+func isCPFOrCNPJ(document string) bool {
+	return cpf.IsValid(document) || cnpj.IsValid(document)
+}
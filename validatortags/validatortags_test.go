@@ -0,0 +1,104 @@
+package validatortags_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/brazilian-utils/go/validatortags"
+)
+
+type customer struct {
+	CPF          string `validate:"cpf"`
+	CNPJ         string `validate:"omitempty,cnpj"`
+	Document     string `validate:"cpfcnpj"`
+	CEP          string `validate:"cep"`
+	Mobile       string `validate:"phone_mobile"`
+	Landline     string `validate:"omitempty,phone_landline"`
+	PIS          string `validate:"omitempty,pis"`
+	CNH          string `validate:"omitempty,cnh"`
+	RENAVAM      string `validate:"omitempty,renavam"`
+	VoterID      string `validate:"omitempty,voterid"`
+	Boleto       string `validate:"omitempty,boleto"`
+	LegalProcess string `validate:"omitempty,legalprocess"`
+	LicensePlate string `validate:"omitempty,licenseplate"`
+	LegalNature  string `validate:"omitempty,legalnature"`
+}
+
+func validCustomer() customer {
+	return customer{
+		CPF:          "403.644.788-29",
+		CNPJ:         "11.222.333/0001-81",
+		Document:     "11222333000181",
+		CEP:          "01001000",
+		Mobile:       "11994029275",
+		Landline:     "1635014415",
+		PIS:          "12345678900",
+		CNH:          "98765432100",
+		RENAVAM:      "86769597308",
+		VoterID:      "690847092828",
+		Boleto:       "00190000090114971860168524522114675860000102656",
+		LegalProcess: "6847650-60.2023.3.03.0000",
+		LicensePlate: "ABC1D23",
+		LegalNature:  "2062",
+	}
+}
+
+func TestValid(t *testing.T) {
+	if err := validatortags.New().Struct(validCustomer()); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	c := validCustomer()
+	c.CPF = "403.644.788-28"
+	c.Mobile = "1635014415"
+	c.LegalNature = "9999"
+
+	err := validatortags.New().Struct(c)
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected validation errors, got %v", err)
+	}
+
+	failed := make(map[string]string)
+	for _, fieldError := range validationErrors {
+		failed[fieldError.Field()] = fieldError.Tag()
+	}
+
+	expected := map[string]string{"CPF": "cpf", "Mobile": "phone_mobile", "LegalNature": "legalnature"}
+	if len(failed) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, failed)
+	}
+	for field, tag := range expected {
+		if failed[field] != tag {
+			t.Errorf("Expected %v to fail tag %v, got %v", field, tag, failed[field])
+		}
+	}
+}
+
+func TestNonStringField(t *testing.T) {
+	type invalid struct {
+		CPF int `validate:"cpf"`
+	}
+
+	if err := validatortags.New().Struct(invalid{CPF: 40364478829}); err == nil {
+		t.Error("Expected error for non-string field")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	v := validator.New()
+	if err := validatortags.Register(v); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := v.Var("11.222.333/0001-81", "cpfcnpj"); err != nil {
+		t.Errorf("Expected CNPJ to satisfy cpfcnpj, got %v", err)
+	}
+	if err := v.Var("11.222.333/0001-82", "cpfcnpj"); err == nil {
+		t.Error("Expected invalid CNPJ to fail cpfcnpj")
+	}
+}
@@ -16,6 +16,19 @@ func Format(voterID string) string {
 		return ""
 	}
 
+	return format(voterIDNumbers)
+}
+
+// Formatter implements the brutils.Formatter interface for voter IDs using Format.
+type Formatter struct{}
+
+// Format formats input as Format does.
+func (Formatter) Format(input string) string {
+	return Format(input)
+}
+
+// format formats a voter ID with 12 or more characters as "XXXX XXXX XX XX"
+func format(voterIDNumbers string) string {
 	// Truncate to 12 characters for formatting (standard length)
 	if len(voterIDNumbers) > 12 {
 		voterIDNumbers = voterIDNumbers[:12]
@@ -0,0 +1,37 @@
+package voterid
+
+import (
+	"log/slog"
+
+	"github.com/brazilian-utils/go/helpers"
+)
+
+// Digits of a voter ID left visible by Mask
+var defaultVisibleRange = [2]int{4, 10}
+
+// Mask returns the formatted voter ID with its first four and its verifying
+// digits hidden, e.g. "**** 4709 28 **". Returns an empty string if the
+// input does not have a valid voter ID length.
+func Mask(voterID string) string {
+	return MaskRange(voterID, defaultVisibleRange[0], defaultVisibleRange[1])
+}
+
+// MaskRange returns the formatted voter ID with only the digits at indexes
+// [start, end) visible. Returns an empty string if the input does not have
+// a valid voter ID length.
+func MaskRange(voterID string, start int, end int) string {
+	voterIDNumbers := helpers.OnlyNumbers(voterID)
+	if !isLengthValid(voterIDNumbers) {
+		return ""
+	}
+
+	return format(helpers.Mask(voterIDNumbers, [2]int{start, end}))
+}
+
+// Masked is a voter ID that is masked when logged with log/slog.
+type Masked string
+
+// LogValue implements slog.LogValuer.
+func (m Masked) LogValue() slog.Value {
+	return slog.StringValue(Mask(string(m)))
+}
@@ -0,0 +1,42 @@
+package voterid_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/brazilian-utils/go/voterid"
+)
+
+var maskTests = []struct {
+	input    string
+	expected string
+}{
+	{"690847092828", "**** 4709 28 **"},
+	{"6908 4709 28 28", "**** 4709 28 **"},
+	{"12345", ""},
+}
+
+func TestMask(t *testing.T) {
+	for _, table := range maskTests {
+		if res := voterid.Mask(table.input); res != table.expected {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, res)
+		}
+	}
+}
+
+func TestMaskRange(t *testing.T) {
+	if res := voterid.MaskRange("690847092828", 8, 10); res != "**** **** 28 **" {
+		t.Errorf("Expected %v, got %v", "**** **** 28 **", res)
+	}
+}
+
+func TestMaskedLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("test", "value", voterid.Masked("690847092828"))
+
+	if !strings.Contains(buf.String(), `value=`+"\"**** 4709 28 **\"") {
+		t.Errorf("Expected masked value in log, got %v", buf.String())
+	}
+}
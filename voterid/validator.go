@@ -1,11 +1,24 @@
 package voterid
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/brazilian-utils/go/helpers"
 )
 
+// Errors returned by Validate. They can be compared with errors.Is.
+var (
+	ErrInvalidLength           = errors.New("voterid: invalid length")
+	ErrInvalidCharacters       = errors.New("voterid: invalid characters")
+	ErrInvalidFederativeUnion  = errors.New("voterid: invalid federative union")
+	ErrInvalidFirstCheckDigit  = errors.New("voterid: invalid first check digit")
+	ErrInvalidSecondCheckDigit = errors.New("voterid: invalid second check digit")
+)
+
+// Digits and the formatting symbols accepted in a voter ID
+const allowedCharacters = "0123456789.- "
+
 // Valid federative union codes (01-28)
 var validFederativeUnions = []string{
 	"01", "02", "03", "04", "05", "06", "07", "08", "09", "10",
@@ -18,13 +31,27 @@ var spMgCodes = []string{"01", "02"}
 
 // IsValid validates if a given voter ID is valid
 // It checks format, length, federative union codes, and verifying digits
+// Non-digit characters are ignored; use Validate to reject them.
 func IsValid(voterID string) bool {
-	// Get only numbers
-	voterIDNumbers := helpers.OnlyNumbers(voterID)
+	return validate(helpers.OnlyNumbers(voterID)) == nil
+}
+
+// Validate validates a given voter ID and returns the reason why it is
+// invalid, or nil if it is valid.
+func Validate(voterID string) error {
+	if !helpers.ContainsOnly(voterID, allowedCharacters) {
+		return ErrInvalidCharacters
+	}
 
+	return validate(helpers.OnlyNumbers(voterID))
+}
+
+// validate checks the voter ID once it has been reduced to its significant
+// characters, without looking at the symbols that were stripped from it.
+func validate(voterIDNumbers string) error {
 	// Check if it has valid length
 	if !isLengthValid(voterIDNumbers) {
-		return false
+		return ErrInvalidLength
 	}
 
 	// Extract components
@@ -34,24 +61,32 @@ func IsValid(voterID string) bool {
 
 	// Validate federative union
 	if !isFederativeUnionValid(federativeUnion) {
-		return false
+		return ErrInvalidFederativeUnion
 	}
 
 	// Calculate and validate first verifying digit
 	vd1 := calculateVD1(sequentialNumber, federativeUnion)
 	digit1, _ := strconv.Atoi(string(verifyingDigits[0]))
 	if vd1 != digit1 {
-		return false
+		return ErrInvalidFirstCheckDigit
 	}
 
 	// Calculate and validate second verifying digit
 	vd2 := calculateVD2(federativeUnion, vd1)
 	digit2, _ := strconv.Atoi(string(verifyingDigits[1]))
 	if vd2 != digit2 {
-		return false
+		return ErrInvalidSecondCheckDigit
 	}
 
-	return true
+	return nil
+}
+
+// Validator implements the brutils.Validator interface for voter IDs using IsValid.
+type Validator struct{}
+
+// Validate reports whether input is valid.
+func (Validator) Validate(input string) bool {
+	return IsValid(input)
 }
 
 // isLengthValid checks if the voter ID has valid length
@@ -1,6 +1,7 @@
 package voterid_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/brazilian-utils/go/voterid"
@@ -21,6 +22,7 @@ var validationTests = []struct {
 	// Valid voter IDs with formatting
 	{"6908 4709 28 28", true},
 	{"1632 0401 09 22", true},
+	{"1632/0401/09/22", true},
 
 	// Invalid: wrong length
 	{"12345", false},
@@ -66,3 +68,25 @@ func BenchmarkIsValid(b *testing.B) {
 		voterid.IsValid("690847092828")
 	}
 }
+
+var validateTests = []struct {
+	input    string
+	expected error
+}{
+	{"690847092828", nil},
+	{"12345", voterid.ErrInvalidLength},
+	{"0123456780312", voterid.ErrInvalidLength},
+	{"abcd1234efgh", voterid.ErrInvalidCharacters},
+	{"1632/0401/09/22", voterid.ErrInvalidCharacters},
+	{"123456782912", voterid.ErrInvalidFederativeUnion},
+	{"690847092838", voterid.ErrInvalidFirstCheckDigit},
+	{"690847092827", voterid.ErrInvalidSecondCheckDigit},
+}
+
+func TestValidateErrors(t *testing.T) {
+	for _, table := range validateTests {
+		if err := voterid.Validate(table.input); !errors.Is(err, table.expected) {
+			t.Errorf("Failing for %v \t Expected: %v | Received: %v", table.input, table.expected, err)
+		}
+	}
+}